@@ -0,0 +1,323 @@
+// kubeassert runs the assertions in one or more declarative assertion files against a Kubernetes cluster, or against
+// rendered manifests when -kustomization or -manifest is set. It exits with a non-zero status if any assertion fails
+// so that it can be used after an upgrade to check that a cluster is ready, or in a pull request to check manifests
+// before they are deployed, without writing Go.
+//
+// Usage:
+//
+//	kubeassert [-kubeconfig path] [-context name] [-junit path] [-json path] file...
+//	kubeassert [-kustomization dir] [-manifest path]... [-junit path] [-json path] file...
+//	kubeassert -print-schema
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+	"github.com/DWSR/kubeassert-go/internal/report"
+	"github.com/DWSR/kubeassert-go/internal/spec"
+)
+
+const (
+	exitFailed = 1
+	exitError  = 2
+)
+
+type (
+	// evaluateFunc evaluates a single assertion.
+	evaluateFunc func(assert assertion.Assertion) (assertion.Result, error)
+
+	// kindNamer is implemented by assertions that know the kind of the resources they select.
+	kindNamer interface {
+		KindName() string
+	}
+
+	// stringsFlag is a flag that may be repeated.
+	stringsFlag []string
+)
+
+var (
+	errAssertionsFailed = errors.New("one or more assertions failed")
+	errNoFiles          = errors.New("at least one assertion file is required")
+	errNotEvaluable     = errors.New("assertion cannot be evaluated")
+)
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+
+	return nil
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := exitCode(ctx, os.Args[1:], os.Stdout, os.Stderr)
+
+	stop()
+	os.Exit(code)
+}
+
+// exitCode runs kubeassert and returns its exit status: 0 if every assertion passed, 1 if an assertion failed and 2
+// if kubeassert could not be run or an assertion could not be evaluated.
+func exitCode(ctx context.Context, args []string, out, errOut io.Writer) int {
+	err := run(ctx, args, out)
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+
+	fmt.Fprintln(errOut, err)
+
+	if errors.Is(err, errAssertionsFailed) {
+		return exitFailed
+	}
+
+	return exitError
+}
+
+func run(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("kubeassert", flag.ContinueOnError)
+	kubeconfig := flags.String("kubeconfig", "", "path to the kubeconfig file (defaults to $KUBECONFIG or ~/.kube/config)")
+	kubeContext := flags.String("context", "", "name of the kubeconfig context to use (defaults to the current context)")
+	junitPath := flags.String("junit", "", "write a JUnit XML report of every check to the supplied path")
+	jsonPath := flags.String("json", "", "write a JSON report of every check to the supplied path")
+	kustomization := flags.String("kustomization", "", "evaluate the assertions against the rendered kustomization "+
+		"in the supplied directory instead of a cluster")
+	manifestPaths := stringsFlag{}
+	flags.Var(&manifestPaths, "manifest", "evaluate the assertions against the objects in the supplied YAML or JSON "+
+		"file instead of a cluster (may be repeated)")
+	printSchema := flags.Bool("print-schema", false, "print the JSON schema of assertion files and exit")
+
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: kubeassert [flags] file...")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *printSchema {
+		schema, err := spec.JSONSchema()
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(out, string(schema))
+
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+
+		return errNoFiles
+	}
+
+	reporter := report.NewReporter()
+
+	var asserts []assertion.Assertion
+
+	for _, path := range flags.Args() {
+		loaded, err := spec.LoadFile(path, assertion.WithReporter(reporter))
+		if err != nil {
+			return err
+		}
+
+		asserts = append(asserts, loaded...)
+	}
+
+	var evaluateFn evaluateFunc
+
+	if *kustomization != "" || len(manifestPaths) > 0 {
+		objs, err := loadObjects(*kustomization, manifestPaths)
+		if err != nil {
+			return err
+		}
+
+		evaluateFn = evaluateObjects(objs)
+	} else {
+		restConfig, err := loadRESTConfig(*kubeconfig, *kubeContext)
+		if err != nil {
+			return err
+		}
+
+		evaluateFn = evaluateInCluster(ctx, restConfig)
+	}
+
+	failed, err := evaluate(asserts, evaluateFn, reporter, out)
+
+	if reportErr := writeReports(reporter, *junitPath, *jsonPath); reportErr != nil {
+		return errors.Join(err, reportErr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%w: %d of %d", errAssertionsFailed, failed, len(asserts))
+	}
+
+	fmt.Fprintf(out, "all %d assertions passed\n", len(asserts))
+
+	return nil
+}
+
+// evaluate evaluates each assertion in turn, printing its result, and returns the number of assertions that failed.
+// An assertion that cannot be evaluated does not stop the remaining assertions from being evaluated: its error is
+// printed and recorded by the reporter, and the errors of every such assertion are returned once all of them have been
+// evaluated.
+func evaluate(
+	asserts []assertion.Assertion,
+	evaluateFn evaluateFunc,
+	reporter *report.Reporter,
+	out io.Writer,
+) (int, error) {
+	var (
+		failed int
+		errs   []error
+	)
+
+	for i, assert := range asserts {
+		name := assertion.AsFeature(assert).Name()
+		start := time.Now()
+
+		res, err := evaluateFn(assert)
+
+		fmt.Fprintf(out, "=== %s #%d\n", name, i+1)
+
+		if len(res.Checks) > 0 {
+			fmt.Fprintln(out, res.String())
+		}
+
+		if err != nil {
+			err = fmt.Errorf("%s #%d: %w", name, i+1, err)
+			errs = append(errs, err)
+
+			fmt.Fprintf(out, "ERROR %s\n", err)
+			reporter.Record(report.Record{
+				Kind:       kindName(assert, name),
+				Feature:    name,
+				Check:      "evaluate",
+				Start:      start,
+				Duration:   time.Since(start),
+				Diagnostic: err.Error(),
+			})
+
+			continue
+		}
+
+		if !res.Passed() {
+			failed++
+		}
+	}
+
+	return failed, errors.Join(errs...)
+}
+
+// kindName returns the name of the kind of the resources selected by the assertion, or the fallback if it is not
+// known.
+func kindName(assert assertion.Assertion, fallback string) string {
+	if namer, ok := assert.(kindNamer); ok {
+		return namer.KindName()
+	}
+
+	return fallback
+}
+
+func evaluateInCluster(ctx context.Context, restConfig *rest.Config) evaluateFunc {
+	return func(assert assertion.Assertion) (assertion.Result, error) {
+		evaluator, ok := assert.(assertion.Evaluator)
+		if !ok {
+			return assertion.Result{}, errNotEvaluable
+		}
+
+		return evaluator.Evaluate(ctx, restConfig)
+	}
+}
+
+func evaluateObjects(objs []unstructured.Unstructured) evaluateFunc {
+	return func(assert assertion.Assertion) (assertion.Result, error) {
+		evaluator, ok := assert.(assertion.ObjectEvaluator)
+		if !ok {
+			return assertion.Result{}, errNotEvaluable
+		}
+
+		return evaluator.EvaluateObjects(objs)
+	}
+}
+
+// loadObjects renders the kustomization, if any, and reads the manifests.
+func loadObjects(kustomization string, manifestPaths []string) ([]unstructured.Unstructured, error) {
+	objs, err := manifests.ReadFiles(manifestPaths...)
+	if err != nil {
+		return nil, err
+	}
+
+	if kustomization == "" {
+		return objs, nil
+	}
+
+	rendered, err := manifests.RenderKustomization(kustomization)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(objs, rendered...), nil
+}
+
+// writeReports writes the checks recorded by the reporter to the supplied paths. Empty paths are skipped.
+func writeReports(reporter *report.Reporter, junitPath, jsonPath string) error {
+	if junitPath != "" {
+		if err := writeFile(junitPath, reporter.WriteJUnit); err != nil {
+			return err
+		}
+	}
+
+	if jsonPath != "" {
+		return writeFile(jsonPath, reporter.WriteJSON)
+	}
+
+	return nil
+}
+
+func writeFile(path string, write func(io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := write(file); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func loadRESTConfig(kubeconfig, kubeContext string) (*rest.Config, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext},
+	).ClientConfig()
+}
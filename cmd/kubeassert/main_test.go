@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const manifestPath = "testdata/manifest.yaml"
+
+// newKubeconfig starts an API server that serves the default Namespace and forbids listing Secrets, and returns the
+// path of a kubeconfig for it.
+func newKubeconfig(t *testing.T) string {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/namespaces", func(writer http.ResponseWriter, req *http.Request) {
+		selector, err := fields.ParseSelector(req.URL.Query().Get("fieldSelector"))
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		list := corev1.NamespaceList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "NamespaceList"}}
+		namespace := corev1.Namespace{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		}
+
+		if selector.Matches(fields.Set{"metadata.name": namespace.Name}) {
+			list.Items = append(list.Items, namespace)
+		}
+
+		writeJSON(writer, http.StatusOK, list)
+	})
+	mux.HandleFunc("GET /api/v1/secrets", func(writer http.ResponseWriter, _ *http.Request) {
+		status := apierrors.NewForbidden(corev1.Resource("secrets"), "", nil).Status()
+		status.APIVersion, status.Kind = "v1", "Status"
+
+		writeJSON(writer, http.StatusForbidden, status)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+  - name: test
+    cluster:
+      server: %s
+contexts:
+  - name: test
+    context:
+      cluster: test
+current-context: test
+`, server.URL)
+
+	require.NoError(t, os.WriteFile(path, []byte(kubeconfig), 0o600))
+
+	return path
+}
+
+func writeJSON(writer http.ResponseWriter, status int, body any) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(body)
+}
+
+func TestExitCode(t *testing.T) {
+	kubeconfig := newKubeconfig(t)
+
+	testCases := []struct {
+		name     string
+		args     []string
+		exitCode int
+		out      string
+		errOut   string
+	}{
+		{
+			name:     "Passed",
+			args:     []string{"-kubeconfig", kubeconfig, "testdata/pass.yaml"},
+			exitCode: 0,
+			out:      "all 1 assertions passed",
+		},
+		{
+			name:     "Failed",
+			args:     []string{"-kubeconfig", kubeconfig, "testdata/fail.yaml"},
+			exitCode: exitFailed,
+			out:      "=== Namespace #2\nPASS",
+			errOut:   errAssertionsFailed.Error() + ": 1 of 2",
+		},
+		{
+			name:     "Forbidden",
+			args:     []string{"-kubeconfig", kubeconfig, "testdata/forbidden.yaml"},
+			exitCode: exitError,
+			errOut:   "forbidden",
+		},
+		{
+			name:     "Manifest_Passed",
+			args:     []string{"-manifest", manifestPath, "testdata/pass.yaml"},
+			exitCode: 0,
+			out:      "all 1 assertions passed",
+		},
+		{
+			name:     "Manifest_Failed",
+			args:     []string{"-manifest", manifestPath, "testdata/fail.yaml"},
+			exitCode: exitFailed,
+			out:      "=== Namespace #2\nPASS",
+			errOut:   errAssertionsFailed.Error() + ": 1 of 2",
+		},
+		{
+			// The assertion after the one that cannot be evaluated offline must still be evaluated.
+			name:     "Manifest_Error",
+			args:     []string{"-manifest", manifestPath, "testdata/error.yaml"},
+			exitCode: exitError,
+			out:      "=== Namespace #2\nPASS",
+			errOut:   "check requires a cluster",
+		},
+		{
+			name:     "Manifest_Missing",
+			args:     []string{"-manifest", "testdata/missing.yaml", "testdata/pass.yaml"},
+			exitCode: exitError,
+			errOut:   "no such file or directory",
+		},
+		{
+			name:     "Help",
+			args:     []string{"-h"},
+			exitCode: 0,
+		},
+		{
+			name:     "UnknownFlag",
+			args:     []string{"-unknown", "testdata/pass.yaml"},
+			exitCode: exitError,
+			errOut:   "flag provided but not defined: -unknown",
+		},
+		{
+			name:     "NoFiles",
+			args:     []string{"-kubeconfig", kubeconfig},
+			exitCode: exitError,
+			errOut:   errNoFiles.Error(),
+		},
+		{
+			name:     "MissingFile",
+			args:     []string{"-kubeconfig", kubeconfig, "testdata/missing.yaml"},
+			exitCode: exitError,
+			errOut:   "no such file or directory",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out, errOut bytes.Buffer
+
+			require.Equal(t, tc.exitCode, exitCode(context.Background(), tc.args, &out, &errOut), errOut.String())
+			require.Contains(t, out.String(), tc.out)
+			require.Contains(t, errOut.String(), tc.errOut)
+		})
+	}
+}
+
+func TestRun_PrintSchema(t *testing.T) {
+	var out bytes.Buffer
+
+	require.NoError(t, run(context.Background(), []string{"-print-schema"}, &out))
+
+	expected, err := os.ReadFile("../../docs/schema/v1alpha1.json")
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), out.String())
+}
+
+func TestRun_Reports(t *testing.T) {
+	jsonPath := filepath.Join(t.TempDir(), "report.json")
+	junitPath := filepath.Join(t.TempDir(), "report.xml")
+
+	err := run(
+		context.Background(),
+		[]string{"-kubeconfig", newKubeconfig(t), "-json", jsonPath, "-junit", junitPath, "testdata/fail.yaml"},
+		&bytes.Buffer{},
+	)
+	require.ErrorIs(t, err, errAssertionsFailed)
+
+	raw, err := os.ReadFile(jsonPath)
+	require.NoError(t, err)
+
+	var records []struct {
+		Kind   string `json:"kind"`
+		Check  string `json:"check"`
+		Passed bool   `json:"passed"`
+	}
+
+	require.NoError(t, json.Unmarshal(raw, &records))
+	require.Len(t, records, 2)
+	require.Equal(t, "Namespace", records[0].Kind)
+	require.False(t, records[0].Passed)
+	require.True(t, records[1].Passed)
+
+	junit, err := os.ReadFile(junitPath)
+	require.NoError(t, err)
+	require.Contains(t, string(junit), `failures="1"`)
+}
+
+func TestRun_ReportsAssertionsThatCannotBeEvaluated(t *testing.T) {
+	jsonPath := filepath.Join(t.TempDir(), "report.json")
+	junitPath := filepath.Join(t.TempDir(), "report.xml")
+
+	err := run(
+		context.Background(),
+		[]string{"-manifest", manifestPath, "-json", jsonPath, "-junit", junitPath, "testdata/error.yaml"},
+		&bytes.Buffer{},
+	)
+	require.ErrorContains(t, err, "Service #1")
+
+	raw, err := os.ReadFile(jsonPath)
+	require.NoError(t, err)
+
+	var records []struct {
+		Kind   string `json:"kind"`
+		Check  string `json:"check"`
+		Passed bool   `json:"passed"`
+	}
+
+	require.NoError(t, json.Unmarshal(raw, &records))
+	require.Len(t, records, 2)
+	require.Equal(t, "Service", records[0].Kind)
+	require.Equal(t, "evaluate", records[0].Check)
+	require.False(t, records[0].Passed)
+	require.Equal(t, "Namespace", records[1].Kind)
+	require.True(t, records[1].Passed)
+
+	junit, err := os.ReadFile(junitPath)
+	require.NoError(t, err)
+	require.Contains(t, string(junit), `failures="1"`)
+}
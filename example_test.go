@@ -0,0 +1,39 @@
+package kubeassert_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/e2e-framework/pkg/env"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go"
+)
+
+// A Monitor asserts that at least 2 coredns replicas stay available while a setup step of another assertion applies a
+// new version of a kustomization. Its teardown step fails the test if they did not.
+func ExampleMonitor() {
+	testRollout := func(t *testing.T, testEnv env.Environment) {
+		monitor := kubeassert.NewDeploymentAssertion(
+			kubeassert.WithNamespace("kube-system"),
+			kubeassert.WithResourceName("coredns"),
+		).AtLeastNAreAvailable(2).Monitor()
+
+		applyV2 := func(ctx context.Context, t *testing.T, cfg *envconf.Config) context.Context {
+			ctx, err := kubeassert.ApplyKustomization("./v2")(ctx, cfg)
+			require.NoError(t, err)
+
+			return ctx
+		}
+
+		kubeassert.TestAssertions(t, testEnv, kubeassert.NewDeploymentAssertion(
+			kubeassert.WithNamespace("kube-system"),
+			kubeassert.WithResourceName("coredns"),
+			kubeassert.WithSetup(monitor.Start(), applyV2),
+			kubeassert.WithTeardown(monitor.Stop()),
+		).IsAvailable())
+	}
+
+	_ = testRollout
+}
@@ -3,12 +3,19 @@
 package assertion
 
 import (
+	"context"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apimachinerywait "k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/e2e-framework/klient/wait"
 	"sigs.k8s.io/e2e-framework/pkg/envconf"
 	"sigs.k8s.io/e2e-framework/pkg/features"
+
+	"github.com/DWSR/kubeassert-go/internal/report"
 )
 
 type (
@@ -19,9 +26,16 @@ type (
 
 		clone() Assertion
 
+		// optionErr returns the error of the first Option that could not be applied, if any.
+		optionErr() error
+
 		// GetLabels returns the labels (i.e. metadata.labels) used to select resources for the assertion.
 		GetLabels() map[string]string
 
+		// GetLabelRequirements returns the set-based label requirements (e.g. "tier in (frontend,backend)") used to
+		// select resources for the assertion in addition to the labels returned by GetLabels.
+		GetLabelRequirements() labels.Requirements
+
 		// GetFields returns the fields used to select resources for the assertion.
 		GetFields() map[string]string
 
@@ -31,6 +45,16 @@ type (
 		// GetInterval returns the interval used when polling for the assertion to be true.
 		GetInterval() time.Duration
 
+		// GetWatch returns whether the assertion waits for resources using a watch instead of polling at an interval.
+		GetWatch() bool
+
+		// GetReporter returns the report.Reporter that records the outcome of each check, if any.
+		GetReporter() *report.Reporter
+
+		// GetTracerProvider returns the trace.TracerProvider used to record spans about the assertion. When nil, the
+		// global TracerProvider is used.
+		GetTracerProvider() trace.TracerProvider
+
 		// GetTimeout returns the timeout used when polling for the assertion to be true.
 		GetTimeout() time.Duration
 
@@ -49,11 +73,16 @@ type (
 	// the package.
 	optionSetters interface {
 		setLabels(assertLabels map[string]string)
+		addLabelRequirements(reqs ...labels.Requirement)
 		setFields(assertFields map[string]string)
 		setListOptionsFn(fn listOptionsFunc)
 		setInterval(interval time.Duration)
+		setWatch(watch bool)
+		setReporter(reporter *report.Reporter)
+		setTracerProvider(provider trace.TracerProvider)
 		setTimeout(timeout time.Duration)
 		setRequireT(t require.TestingT)
+		setOptionErr(err error)
 	}
 )
 
@@ -72,3 +101,19 @@ func Clone(a Assertion) Assertion {
 func AsFeature(assert Assertion) features.Feature {
 	return assert.GetBuilder().Feature()
 }
+
+// WaitForCondition waits for a conditionFunc to be satisfied (i.e. return true) based on the timeout and interval set
+// on the Assertion.
+func WaitForCondition(
+	ctx context.Context,
+	assert Assertion,
+	conditionFunc apimachinerywait.ConditionWithContextFunc,
+) error {
+	return wait.For(
+		conditionFunc,
+		wait.WithContext(ctx),
+		wait.WithTimeout(assert.GetTimeout()),
+		wait.WithInterval(assert.GetInterval()),
+		wait.WithImmediate(),
+	)
+}
@@ -1,16 +1,25 @@
 package assertion
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/e2e-framework/pkg/features"
 	e2etypes "sigs.k8s.io/e2e-framework/pkg/types"
+
+	"github.com/DWSR/kubeassert-go/internal/report"
 )
 
 // Option is a function that configures one or more facets of an Assertion.
 type Option func(Assertion)
 
+// ErrInvalidLabelSelector is returned when a label selector cannot be parsed.
+var ErrInvalidLabelSelector = errors.New("invalid label selector")
+
 // WithResourceLabels sets the labels to be used when selecting resources for the assertion.
 func WithResourceLabels(labels map[string]string) Option {
 	return func(a Assertion) {
@@ -18,6 +27,31 @@ func WithResourceLabels(labels map[string]string) Option {
 	}
 }
 
+// WithLabelSelector adds the requirements of a label selector (e.g. "tier in (frontend,backend),!canary") to the
+// requirements used when selecting resources for the assertion. If the selector cannot be parsed, every check of the
+// assertion fails with ErrInvalidLabelSelector instead of being evaluated.
+func WithLabelSelector(selector string) Option {
+	reqs, err := labels.ParseToRequirements(selector)
+
+	return func(a Assertion) {
+		if err != nil {
+			a.setOptionErr(fmt.Errorf("%w %q: %w", ErrInvalidLabelSelector, selector, err))
+
+			return
+		}
+
+		a.addLabelRequirements(reqs...)
+	}
+}
+
+// WithLabelRequirements adds set-based label requirements (i.e. In, NotIn, Exists, DoesNotExist) to the requirements
+// used when selecting resources for the assertion.
+func WithLabelRequirements(reqs ...labels.Requirement) Option {
+	return func(a Assertion) {
+		a.addLabelRequirements(reqs...)
+	}
+}
+
 // WithResourceFields sets the fields to be used when selecting resources for the assertion.
 func WithResourceFields(fields map[string]string) Option {
 	return func(a Assertion) {
@@ -32,6 +66,31 @@ func WithInterval(interval time.Duration) Option {
 	}
 }
 
+// WithWatch makes the assertion watch the selected resources and re-evaluate its checks whenever a resource is added,
+// modified or deleted instead of listing them at a fixed interval. This reduces load on the API server and reacts to
+// changes immediately. The interval is ignored when watching.
+func WithWatch() Option {
+	return func(a Assertion) {
+		a.setWatch(true)
+	}
+}
+
+// WithReporter records the outcome of each check made by the assertion, including its duration and diagnostic, in the
+// supplied report.Reporter so that it can be written as JUnit XML or JSON.
+func WithReporter(reporter *report.Reporter) Option {
+	return func(a Assertion) {
+		a.setReporter(reporter)
+	}
+}
+
+// WithTracerProvider sets the trace.TracerProvider used to record spans for each check, poll attempt and request to
+// the API server. The global TracerProvider (see otel.SetTracerProvider) is used by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(a Assertion) {
+		a.setTracerProvider(provider)
+	}
+}
+
 // WithTimeout sets the timeout used when polling for the assertion to be true.
 func WithTimeout(timeout time.Duration) Option {
 	return func(a Assertion) {
@@ -63,7 +122,8 @@ func WithResourceNamespace(namespaceName string) Option {
 }
 
 // WithResourceNamespaceFromTestEnv sets the namespace to be used when selecting resources for the assertion to the
-// namespace set in the test environment.
+// namespace set in the test environment. If the test environment has no namespace, only resources without a namespace
+// (i.e. cluster scoped resources) are selected.
 func WithResourceNamespaceFromTestEnv() Option {
 	return func(a Assertion) {
 		a.setListOptionsFn(listOptionsWithNamespaceFromEnv)
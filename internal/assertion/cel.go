@@ -0,0 +1,158 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	// ErrInvalidCEL is returned when a CEL expression cannot be compiled or does not evaluate to a bool.
+	ErrInvalidCEL = errors.New("invalid CEL expression")
+	// ErrCELEvaluation is reported in the Diagnostic of a CEL check for each resource that the expression cannot be
+	// evaluated against (e.g. because a field it refers to is not set).
+	ErrCELEvaluation = errors.New("CEL expression could not be evaluated")
+)
+
+// celEnv returns the CEL environment that expressions are compiled in. As with the validation rules of
+// CustomResourceDefinitions, the object is available as self and the string, list, set and math extensions are
+// enabled.
+//
+//nolint:gochecknoglobals
+var celEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("self", cel.DynType),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+		ext.Math(),
+	)
+})
+
+// CELPredicate compiles the CEL expression into a Predicate that is evaluated against each resource, which is available
+// as self (e.g. "self.spec.replicas >= 2 && self.status.readyReplicas == self.spec.replicas"). The expression must
+// evaluate to a bool. A resource does not satisfy the Predicate if the expression cannot be evaluated against it
+// (e.g. a field it refers to is not set), so optional fields should be guarded with has(). For the same reason, the
+// Predicate should not be negated with Not: negate the expression or use NoneWhereCEL instead, which report resources
+// that the expression cannot be evaluated against as errors.
+func CELPredicate[T any](expression string) (Predicate[T], error) {
+	eval, err := celEval[T](expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(item T) bool {
+		satisfied, err := eval(context.Background(), item)
+
+		return err == nil && satisfied
+	}, nil
+}
+
+// celEval compiles the CEL expression into a ContextPredicate that returns whether a resource satisfies it, or an error
+// wrapping ErrCELEvaluation if the expression cannot be evaluated against the resource.
+func celEval[T any](expression string) (ContextPredicate[T], error) {
+	env, err := celEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrInvalidCEL, expression, issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("%w %q: evaluates to %s rather than bool", ErrInvalidCEL, expression, ast.OutputType())
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrInvalidCEL, expression, err)
+	}
+
+	return func(_ context.Context, item T) (bool, error) {
+		content, err := toUnstructuredContent(&item)
+		if err != nil {
+			return false, fmt.Errorf("%w: %w", ErrCELEvaluation, err)
+		}
+
+		out, _, err := program.Eval(map[string]any{"self": content})
+		if err != nil {
+			return false, fmt.Errorf("%w: %w", ErrCELEvaluation, err)
+		}
+
+		satisfied, ok := out.Value().(bool)
+		if !ok {
+			return false, fmt.Errorf("%w: evaluates to %s rather than bool", ErrCELEvaluation, out.Type().TypeName())
+		}
+
+		return satisfied, nil
+	}, nil
+}
+
+// WhereCEL asserts that at least one resource exists in the cluster that matches the provided options and that every
+// one of them satisfies the CEL expression (see CELPredicate). The expression is shown in diagnostics and recorded on
+// spans, while the check is named "whereCEL" so that the labels of its metrics are bounded. The check fails without
+// being evaluated if the expression is invalid, and is not satisfied while the expression cannot be evaluated against a
+// resource, which is reported as an error in the Diagnostic.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) WhereCEL(expression string) A {
+	return ra.withCELCheck("whereCEL", expression, check[T]{quantifier: quantifierAll})
+}
+
+// ExactlyNWhereCEL asserts that exactly N of the resources in the cluster that match the provided options satisfy the
+// CEL expression (see CELPredicate and ExactlyNWhere).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) ExactlyNWhereCEL(count int, expression string) A {
+	return ra.withCELCheck(
+		"exactlyNWhereCEL",
+		expression,
+		check[T]{quantifier: quantifierExactly, count: count, onlySatisfying: true},
+	)
+}
+
+// AtLeastNWhereCEL asserts that at least N of the resources in the cluster that match the provided options satisfy the
+// CEL expression (see CELPredicate and AtLeastNWhere).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AtLeastNWhereCEL(count int, expression string) A {
+	return ra.withCELCheck(
+		"atLeastNWhereCEL",
+		expression,
+		check[T]{quantifier: quantifierAtLeast, count: count, onlySatisfying: true},
+	)
+}
+
+// NoneWhereCEL asserts that none of the resources in the cluster that match the provided options satisfy the CEL
+// expression (see CELPredicate and NoneMatch). A resource that the expression cannot be evaluated against is reported
+// as an error rather than as not satisfying the expression.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) NoneWhereCEL(expression string) A {
+	return ra.withCELCheck("noneWhereCEL", expression, check[T]{quantifier: quantifierNone})
+}
+
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) withCELCheck(stepName, expression string, chk check[T]) A {
+	chk.detail = expression
+	chk.fallible, chk.err = celEval[T](expression)
+
+	return ra.withCheck(stepName, chk)
+}
+
+// toUnstructuredContent returns the content of the resource as it would be returned by the API server.
+func toUnstructuredContent(obj any) (map[string]any, error) {
+	if item, ok := obj.(*unstructured.Unstructured); ok {
+		return item.Object, nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+}
@@ -0,0 +1,157 @@
+package assertion_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+	"github.com/DWSR/kubeassert-go/internal/resources"
+)
+
+const criticalCEL = `has(self.spec.template.spec.priorityClassName) && ` +
+	`self.spec.template.spec.priorityClassName == "system-cluster-critical"`
+
+func TestWhereCEL(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		assert deploymentAssertion
+		passed bool
+	}{
+		{
+			name:   "WhereCEL",
+			assert: newDeploymentAssertion().WhereCEL(`self.metadata.labels.app.startsWith(self.metadata.name)`),
+			passed: true,
+		},
+		{
+			name:   "WhereCEL_NotAll",
+			assert: newDeploymentAssertion().WhereCEL(criticalCEL),
+			passed: false,
+		},
+		{
+			name:   "WhereCEL_MissingField",
+			assert: newDeploymentAssertion().WhereCEL(`self.spec.template.spec.priorityClassName != ""`),
+			passed: false,
+		},
+		{
+			name:   "ExactlyNWhereCEL",
+			assert: newDeploymentAssertion().ExactlyNWhereCEL(1, criticalCEL),
+			passed: true,
+		},
+		{
+			name:   "AtLeastNWhereCEL",
+			assert: newDeploymentAssertion().AtLeastNWhereCEL(2, criticalCEL),
+			passed: false,
+		},
+		{
+			name:   "NoneWhereCEL",
+			assert: newDeploymentAssertion().NoneWhereCEL(`size(self.spec.template.spec.containers) > 1`),
+			passed: true,
+		},
+		{
+			// A resource that the expression cannot be evaluated against must not count as not satisfying it.
+			name:   "NoneWhereCEL_MissingField",
+			assert: newDeploymentAssertion().NoneWhereCEL(`self.spec.template.spec.priorityClassName == "low"`),
+			passed: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.assert.EvaluateObjects(objs)
+			require.NoError(t, err)
+			require.Len(t, res.Checks, 1)
+			require.Equal(t, testCase.passed, res.Checks[0].Passed, res.String())
+		})
+	}
+}
+
+func TestWhereCEL_EvaluationError(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	res, err := newDeploymentAssertion().
+		NoneWhereCEL(`!(self.spec.template.spec.priorityClassName == "system-cluster-critical")`).
+		EvaluateObjects(objs)
+	require.NoError(t, err)
+	require.False(t, res.Passed(), res.String())
+
+	diag := res.Checks[0].Diagnostic
+	errored := 0
+
+	for _, obj := range diag.Objects {
+		if obj.Err != nil {
+			require.ErrorIs(t, obj.Err, assertion.ErrCELEvaluation)
+			require.False(t, obj.Satisfied)
+
+			errored++
+		}
+	}
+
+	require.Positive(t, errored)
+	require.Contains(t, diag.String(), ": error: "+assertion.ErrCELEvaluation.Error())
+}
+
+func TestWhereCEL_CheckName(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	expression := `self.metadata.name.startsWith("back")`
+
+	testCases := []struct {
+		check  string
+		assert assertion.ObjectEvaluator
+	}{
+		{check: "whereCEL", assert: newDeploymentAssertion().WhereCEL(expression)},
+		{check: "exactlyNWhereCEL", assert: newDeploymentAssertion().ExactlyNWhereCEL(1, expression)},
+		{check: "atLeastNWhereCEL", assert: newDeploymentAssertion().AtLeastNWhereCEL(1, expression)},
+		{check: "noneWhereCEL", assert: newDeploymentAssertion().NoneWhereCEL(expression)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.check, func(t *testing.T) {
+			res, err := testCase.assert.EvaluateObjects(objs)
+			require.NoError(t, err)
+			require.Len(t, res.Checks, 1)
+
+			// The expression is not used as the name, which labels the metrics of the check.
+			diag := res.Checks[0].Diagnostic
+			require.Equal(t, testCase.check, diag.Check)
+			require.Equal(t, expression, diag.Detail)
+			require.Contains(t, diag.String(), fmt.Sprintf("check %q (%s)", testCase.check, expression))
+		})
+	}
+}
+
+func TestWhereCEL_Unstructured(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	assert := resources.NewResourceAssertion(appsv1.SchemeGroupVersion.WithKind("Deployment")).
+		ExactlyNWhereCEL(1, criticalCEL)
+
+	res, err := assert.EvaluateObjects(objs)
+	require.NoError(t, err)
+	require.True(t, res.Passed(), res.String())
+}
+
+func TestWhereCEL_Invalid(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	for _, expression := range []string{`self.metadata.name ==`, `self.metadata.name + "suffix"`, `1 + 1`} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := assertion.CELPredicate[appsv1.Deployment](expression)
+			require.ErrorIs(t, err, assertion.ErrInvalidCEL)
+
+			_, err = newDeploymentAssertion().WhereCEL(expression).EvaluateObjects(objs)
+			require.ErrorIs(t, err, assertion.ErrInvalidCEL)
+		})
+	}
+}
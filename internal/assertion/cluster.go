@@ -0,0 +1,74 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+)
+
+type (
+	// ContextPredicate reports whether a single resource satisfies a condition, or returns an error if that cannot be
+	// determined (e.g. a request to the cluster failed). ctx is done when the evaluation must stop (e.g. the
+	// assertion's timeout has elapsed).
+	ContextPredicate[T any] func(ctx context.Context, item T) (bool, error)
+
+	// ClusterPredicate returns a ContextPredicate that queries the cluster described by cfg for each resource (e.g. to
+	// read the logs of a Pod) rather than only inspecting the resource. It is called once when a check starts to run
+	// (in a Feature, Evaluate or a Monitor), not on every poll: the ContextPredicate it returns is reused each time the
+	// check is evaluated, with the context of that evaluation, so it must query the cluster with that context every
+	// time it is called rather than caching what it observed. Resources for which the ContextPredicate returns an
+	// error are reported as errors in the Diagnostic and the check is not satisfied. An error returned by the
+	// ClusterPredicate itself fails the check without evaluating it, so it should only be returned if the check
+	// cannot be evaluated at all (e.g. the clients cannot be built).
+	ClusterPredicate[T any] func(ctx context.Context, cfg *envconf.Config) (ContextPredicate[T], error)
+)
+
+// ErrClusterRequired is returned when a check that queries the cluster is evaluated against objects instead of a
+// cluster (see EvaluateObjects).
+var ErrClusterRequired = errors.New("check requires a cluster")
+
+// ExactlyNMatchInCluster asserts that exactly N resources exist in the cluster that match the provided options and that
+// exactly N of them satisfy the ContextPredicate returned by the ClusterPredicate (see ExactlyNMatch).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) ExactlyNMatchInCluster(stepName string, count int, predicate ClusterPredicate[T]) A {
+	return ra.withCheck(stepName, check[T]{quantifier: quantifierExactly, count: count, clusterPredicate: predicate})
+}
+
+// AtLeastNMatchInCluster asserts that at least N resources exist in the cluster that match the provided options and
+// that at least N of them satisfy the ContextPredicate returned by the ClusterPredicate (see AtLeastNMatch).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AtLeastNMatchInCluster(stepName string, count int, predicate ClusterPredicate[T]) A {
+	return ra.withCheck(stepName, check[T]{quantifier: quantifierAtLeast, count: count, clusterPredicate: predicate})
+}
+
+// WhereInCluster asserts that at least one resource exists in the cluster that matches the provided options and that
+// every one of them satisfies the ContextPredicate returned by the ClusterPredicate (see Where).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) WhereInCluster(name string, predicate ClusterPredicate[T]) A {
+	return ra.withCheck(name, check[T]{quantifier: quantifierAll, clusterPredicate: predicate})
+}
+
+// resolve returns the check with the ContextPredicate returned by its ClusterPredicate, if any, for the cluster
+// described by cfg.
+func (c check[T]) resolve(ctx context.Context, cfg *envconf.Config) (check[T], error) {
+	if c.err != nil {
+		return c, c.err
+	}
+
+	if c.clusterPredicate == nil {
+		return c, nil
+	}
+
+	predicate, err := c.clusterPredicate(ctx, cfg)
+	if err != nil {
+		return c, err
+	}
+
+	c.fallible = predicate
+
+	return c, nil
+}
@@ -1,13 +1,17 @@
 package assertion
 
 import (
+	"slices"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/e2e-framework/pkg/envconf"
 	"sigs.k8s.io/e2e-framework/pkg/features"
+
+	"github.com/DWSR/kubeassert-go/internal/report"
 )
 
 type (
@@ -20,6 +24,11 @@ type (
 		timeout           time.Duration
 		requireT          require.TestingT
 		listOptionsFn     listOptionsFunc
+		watch             bool
+		reporter          *report.Reporter
+		tracerProvider    trace.TracerProvider
+		// err is the error of the first Option that could not be applied. It fails every check of the assertion.
+		err error
 	}
 )
 
@@ -36,6 +45,14 @@ func (ca *commonAssertion) GetLabels() map[string]string {
 	return ca.assertLabels
 }
 
+func (ca *commonAssertion) addLabelRequirements(reqs ...labels.Requirement) {
+	ca.labelRequirements = append(ca.labelRequirements, reqs...)
+}
+
+func (ca *commonAssertion) GetLabelRequirements() labels.Requirements {
+	return ca.labelRequirements
+}
+
 func (ca *commonAssertion) setFields(assertFields map[string]string) {
 	ca.assertFields = assertFields
 }
@@ -52,6 +69,31 @@ func (ca *commonAssertion) GetInterval() time.Duration {
 	return ca.interval
 }
 
+func (ca *commonAssertion) setWatch(watch bool) {
+	ca.watch = watch
+}
+
+func (ca *commonAssertion) GetWatch() bool {
+	return ca.watch
+}
+
+func (ca *commonAssertion) setReporter(reporter *report.Reporter) {
+	ca.reporter = reporter
+}
+
+func (ca *commonAssertion) GetReporter() *report.Reporter {
+	return ca.reporter
+}
+
+func (ca *commonAssertion) setTracerProvider(provider trace.TracerProvider) {
+	ca.tracerProvider = provider
+}
+
+//nolint:ireturn
+func (ca *commonAssertion) GetTracerProvider() trace.TracerProvider {
+	return ca.tracerProvider
+}
+
 func (ca *commonAssertion) setTimeout(timeout time.Duration) {
 	ca.timeout = timeout
 }
@@ -77,6 +119,16 @@ func (ca *commonAssertion) GetRequireT() require.TestingT {
 	return ca.requireT
 }
 
+func (ca *commonAssertion) setOptionErr(err error) {
+	if ca.err == nil {
+		ca.err = err
+	}
+}
+
+func (ca *commonAssertion) optionErr() error {
+	return ca.err
+}
+
 func (ca *commonAssertion) setListOptionsFn(fn listOptionsFunc) {
 	ca.listOptionsFn = fn
 }
@@ -92,10 +144,14 @@ func (ca *commonAssertion) clone() Assertion {
 		interval:          ca.interval,
 		assertFields:      ca.assertFields,
 		assertLabels:      ca.assertLabels,
-		labelRequirements: ca.labelRequirements,
+		labelRequirements: slices.Clone(ca.labelRequirements),
 		timeout:           ca.timeout,
 		requireT:          ca.requireT,
 		listOptionsFn:     ca.listOptionsFn,
+		watch:             ca.watch,
+		reporter:          ca.reporter,
+		tracerProvider:    ca.tracerProvider,
+		err:               ca.err,
 	}
 }
 
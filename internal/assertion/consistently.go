@@ -0,0 +1,75 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimachinerywait "k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+)
+
+// ErrNotConsistent is returned when a check that must hold for a window of time is not satisfied within the window.
+var ErrNotConsistent = errors.New("check was not satisfied for the whole window")
+
+// holdConsistently evaluates the selected resources until the window elapses and returns ErrNotConsistent the first
+// time evaluate returns false. Resources are watched when the assertion uses a watch and otherwise listed at the
+// assertion's interval. Retryable errors are passed to observeErr and skipped, but ErrNotConsistent is also returned
+// if the resources could not be evaluated at all during the window (e.g. the API server was unavailable throughout).
+func (ra ResourceAssertion[T, A]) holdConsistently(
+	ctx context.Context,
+	cfg *envconf.Config,
+	window time.Duration,
+	evaluate func(context.Context, []T) bool,
+	observeErr func(error),
+) error {
+	start := time.Now()
+	violated := false
+	evaluated := false
+
+	var lastErr error
+
+	// Invert the check so that waiting stops as soon as it is violated. Timing out is then the successful outcome.
+	violation := func(ctx context.Context, items []T) bool {
+		evaluated = true
+		violated = !evaluate(ctx, items)
+
+		return violated
+	}
+
+	observe := func(err error) {
+		lastErr = err
+
+		observeErr(err)
+	}
+
+	var err error
+
+	if ra.GetWatch() {
+		err = ra.waitWithWatch(ctx, cfg, window, violation, observe)
+	} else {
+		err = apimachinerywait.PollUntilContextTimeout(
+			ctx,
+			ra.GetInterval(),
+			window,
+			true,
+			ra.conditionFunc(cfg, violation, observe),
+		)
+	}
+
+	switch {
+	case violated:
+		return fmt.Errorf("%w: violated after %s of %s", ErrNotConsistent, time.Since(start).Round(time.Millisecond), window)
+	case ctx.Err() != nil:
+		return ctx.Err()
+	case err != nil && !apimachinerywait.Interrupted(err):
+		return err
+	case !evaluated && lastErr != nil:
+		return fmt.Errorf("%w: not evaluated during %s: %w", ErrNotConsistent, window, lastErr)
+	case !evaluated:
+		return fmt.Errorf("%w: not evaluated during %s", ErrNotConsistent, window)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package assertion_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/e2e-framework/pkg/env"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/clients"
+	"github.com/DWSR/kubeassert-go/internal/testhelpers"
+)
+
+// newUnavailableConfig returns an envconf.Config for a cluster whose API server is unavailable for every request.
+func newUnavailableConfig(t *testing.T) *envconf.Config {
+	t.Helper()
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{appsv1.SchemeGroupVersion.WithResource("deployments"): "DeploymentList"},
+	)
+	client.PrependReactor("*", "*", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewServiceUnavailable("upgrading")
+	})
+	client.PrependWatchReactor("*", func(clienttesting.Action) (bool, watch.Interface, error) {
+		return true, nil, apierrors.NewServiceUnavailable("upgrading")
+	})
+
+	cfg := envconf.NewWithKubeConfig(envconf.RandomName("unavailable", 24))
+	clients.Register(cfg, &clients.Clients{
+		RESTConfig: nil,
+		Dynamic:    client,
+		Mapper:     nil,
+		Logs:       nil,
+		Exec:       nil,
+		Proxy:      nil,
+	})
+
+	t.Cleanup(func() { clients.Forget(cfg) })
+
+	return cfg
+}
+
+func TestConsistently_Unavailable(t *testing.T) {
+	testEnv := env.NewWithConfig(newUnavailableConfig(t))
+
+	options := func(t require.TestingT, opts ...assertion.Option) []assertion.Option {
+		return append([]assertion.Option{
+			assertion.WithRequireT(t),
+			assertion.WithInterval(10 * time.Millisecond),
+		}, opts...)
+	}
+
+	asserts := []testhelpers.FailingAssert{
+		{
+			Name: "Poll",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return newDeploymentAssertion(options(t)...).Consistently(100 * time.Millisecond).NoneExist()
+			},
+		},
+		{
+			Name: "Watch",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return newDeploymentAssertion(options(t, assertion.WithWatch())...).Consistently(100 * time.Millisecond).NoneExist()
+			},
+		},
+	}
+
+	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
+}
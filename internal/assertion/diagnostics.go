@@ -0,0 +1,131 @@
+package assertion
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type (
+	// Diagnostic describes the last observed state of a check made by an assertion. It is included in the failure
+	// message when a check is not satisfied before the assertion times out so that a failing test shows which resources
+	// did not satisfy the check without needing to inspect the cluster.
+	Diagnostic struct {
+		// Check is the name of the check (e.g. "exactlyNAreAvailable").
+		Check string
+		// Detail describes what the check evaluates when its name does not (e.g. the expression of "whereCEL").
+		Detail string
+		// Expected describes the expected number of resources (e.g. "exactly 3").
+		Expected string
+		// Selected is the number of resources that matched the assertion's options.
+		Selected int
+		// Satisfied is the number of selected resources that satisfied the check.
+		Satisfied int
+		// Objects contains the state of each selected resource.
+		Objects []ObjectDiagnostic
+		// Err is the last error encountered while evaluating the check, if any.
+		Err error
+	}
+
+	// ObjectDiagnostic describes whether a single resource satisfied a check.
+	ObjectDiagnostic struct {
+		Namespace string
+		Name      string
+		Satisfied bool
+		// Err is set when the check could not be evaluated against the resource.
+		Err error
+	}
+
+	// diagnosticRecorder records the last Diagnostic of a check. It is safe for concurrent use as watches report errors
+	// from the informer's goroutine.
+	diagnosticRecorder struct {
+		mu   sync.Mutex
+		diag Diagnostic
+		// evaluations is the number of times the check was evaluated.
+		evaluations int
+	}
+)
+
+// String returns a human readable, multi-line representation of the diagnostic.
+func (d Diagnostic) String() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "check %q", d.Check)
+
+	if d.Detail != "" {
+		fmt.Fprintf(&builder, " (%s)", d.Detail)
+	}
+
+	fmt.Fprintf(
+		&builder,
+		": expected %s, observed %d of %d selected resources satisfying the check",
+		d.Expected,
+		d.Satisfied,
+		d.Selected,
+	)
+
+	if d.Err != nil {
+		fmt.Fprintf(&builder, "\nlast error: %v", d.Err)
+	}
+
+	for _, obj := range d.Objects {
+		state := "satisfied"
+
+		switch {
+		case obj.Err != nil:
+			state = fmt.Sprintf("error: %v", obj.Err)
+		case !obj.Satisfied:
+			state = "not satisfied"
+		}
+
+		fmt.Fprintf(&builder, "\n  %s: %s", obj.String(), state)
+	}
+
+	return builder.String()
+}
+
+// String returns the namespaced name of the resource.
+func (od ObjectDiagnostic) String() string {
+	if od.Namespace == "" {
+		return od.Name
+	}
+
+	return od.Namespace + "/" + od.Name
+}
+
+// newDiagnosticRecorder returns a diagnosticRecorder that describes the check even if it is never evaluated (e.g.
+// because every attempt to list resources failed).
+func newDiagnosticRecorder[T any](chk check[T]) *diagnosticRecorder {
+	return &diagnosticRecorder{
+		diag: Diagnostic{Check: chk.name, Detail: chk.detail, Expected: chk.quantifier.describe(chk.count)},
+	}
+}
+
+func (r *diagnosticRecorder) record(diag Diagnostic) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.diag = diag
+	r.evaluations++
+}
+
+func (r *diagnosticRecorder) recordErr(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.diag.Err = err
+}
+
+func (r *diagnosticRecorder) attempts() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.evaluations
+}
+
+func (r *diagnosticRecorder) last() Diagnostic {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.diag
+}
@@ -0,0 +1,37 @@
+package assertion
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+// IsRetryable reports whether an error encountered while evaluating an assertion is likely to be transient, in which
+// case the assertion keeps polling until it times out rather than failing immediately. This covers errors seen while
+// the control plane is being upgraded or is overloaded (e.g. 429, 503, connection resets) as well as resources that are
+// not served yet (e.g. a CRD that has not been established). Errors that retrying will not fix, such as Forbidden or
+// Unauthorized, are not retryable.
+func IsRetryable(err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case apierrors.IsTooManyRequests(err),
+		apierrors.IsServiceUnavailable(err),
+		apierrors.IsServerTimeout(err),
+		apierrors.IsTimeout(err),
+		apierrors.IsInternalError(err),
+		apierrors.IsUnexpectedServerError(err),
+		apierrors.IsNotFound(err),
+		apierrors.IsGone(err),
+		meta.IsNoMatchError(err):
+		return true
+	case utilnet.IsConnectionReset(err),
+		utilnet.IsConnectionRefused(err),
+		utilnet.IsHTTP2ConnectionLost(err),
+		utilnet.IsProbableEOF(err),
+		utilnet.IsTimeout(err):
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+package assertion_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+)
+
+func TestIsRetryable(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+
+	testCases := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{name: "Nil", err: nil, retryable: false},
+		{name: "TooManyRequests", err: apierrors.NewTooManyRequests("slow down", 1), retryable: true},
+		{name: "ServiceUnavailable", err: apierrors.NewServiceUnavailable("upgrading"), retryable: true},
+		{name: "InternalError", err: apierrors.NewInternalError(errors.New("etcd")), retryable: true},
+		{name: "NotFound", err: apierrors.NewNotFound(deployments, ""), retryable: true},
+		{name: "ConnectionReset", err: fmt.Errorf("list: %w", syscall.ECONNRESET), retryable: true},
+		{name: "ConnectionRefused", err: fmt.Errorf("list: %w", syscall.ECONNREFUSED), retryable: true},
+		{name: "UnexpectedEOF", err: io.ErrUnexpectedEOF, retryable: true},
+		{name: "Forbidden", err: apierrors.NewForbidden(deployments, "", errors.New("rbac")), retryable: false},
+		{name: "Unauthorized", err: apierrors.NewUnauthorized("expired"), retryable: false},
+		{name: "BadRequest", err: apierrors.NewBadRequest("invalid selector"), retryable: false},
+		{name: "Other", err: errors.New("boom"), retryable: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.retryable, assertion.IsRetryable(tc.err))
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimachinerywait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+
+	"github.com/DWSR/kubeassert-go/internal/clients"
+)
+
+type (
+	// Evaluator is implemented by assertions that can be evaluated outside of an e2e-framework Feature.
+	Evaluator interface {
+		// Evaluate evaluates the checks of the assertion against the cluster described by the REST configuration.
+		Evaluate(ctx context.Context, restConfig *rest.Config) (Result, error)
+	}
+
+	// Result is the outcome of evaluating an assertion outside of an e2e-framework Feature.
+	Result struct {
+		// Checks contains the outcome of each check in the order they were added to the assertion.
+		Checks []CheckResult
+	}
+
+	// CheckResult is the outcome of evaluating a single check.
+	CheckResult struct {
+		// Passed is true if the check was satisfied within the assertion's timeout, or held for the whole window when
+		// added after Consistently.
+		Passed bool
+		// Diagnostic is the last state observed while evaluating the check.
+		Diagnostic Diagnostic
+	}
+)
+
+// Passed returns true if every check was satisfied.
+func (r Result) Passed() bool {
+	for _, chk := range r.Checks {
+		if !chk.Passed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns a human readable, multi-line summary of the outcome of each check.
+func (r Result) String() string {
+	lines := make([]string, 0, len(r.Checks))
+
+	for _, chk := range r.Checks {
+		state := "PASS"
+		if !chk.Passed {
+			state = "FAIL"
+		}
+
+		lines = append(lines, fmt.Sprintf("%s %s", state, chk.Diagnostic.String()))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Evaluate evaluates the checks of the assertion against the cluster described by the REST configuration using the
+// same timeout, interval, watch and Consistently settings as the e2e-framework Feature, without requiring a
+// testing.T or an env.Environment. This enables assertions to be reused outside of tests (e.g. in operators or health
+// check jobs). Clients are cached for each *rest.Config, so a process that evaluates assertions with a new
+// *rest.Config each time must release it with clients.Release once it is no longer used.
+//
+// Every check is evaluated, even if an earlier check failed, and its outcome is reported in the Result. An error is
+// only returned if a check could not be evaluated (e.g. a resource type is forbidden) or ctx is done, in which case the
+// Result contains the checks evaluated so far. Setup and teardown steps are not run. There is no test environment, so
+// assertions created with WithResourceNamespaceFromTestEnv only select resources without a namespace and should use
+// WithResourceNamespace instead.
+func (ra ResourceAssertion[T, A]) Evaluate(ctx context.Context, restConfig *rest.Config) (Result, error) {
+	cfg, err := clients.ConfigFor(restConfig)
+	if err != nil {
+		return Result{}, err
+	}
+
+	res := Result{Checks: make([]CheckResult, 0, len(ra.checks))}
+
+	for _, chk := range ra.checks {
+		diag, err := ra.runCheck(ctx, cfg, chk)
+
+		res.Checks = append(res.Checks, CheckResult{Passed: err == nil, Diagnostic: diag})
+
+		switch {
+		case err == nil:
+		case ctx.Err() != nil:
+			return res, ctx.Err()
+		case !isCheckFailure(err):
+			return res, err
+		}
+	}
+
+	return res, nil
+}
+
+// isCheckFailure returns true if the error means that a check was not satisfied rather than that it could not be
+// evaluated.
+func isCheckFailure(err error) bool {
+	return apimachinerywait.Interrupted(err) || errors.Is(err, ErrNotConsistent)
+}
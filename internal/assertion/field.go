@@ -0,0 +1,240 @@
+package assertion
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// FieldMatcher matches the values of a field selected by a JSONPath expression (see HasField).
+type FieldMatcher struct {
+	// match returns true if the values satisfy the matcher. The values are empty when the field is absent.
+	match func(values []any) bool
+	// err is set when the matcher could not be created (e.g. from an invalid regular expression).
+	err error
+}
+
+var (
+	// ErrInvalidJSONPath is returned when a JSONPath expression cannot be parsed.
+	ErrInvalidJSONPath = errors.New("invalid JSONPath expression")
+	// ErrInvalidFieldMatcher is returned when a FieldMatcher cannot be created.
+	ErrInvalidFieldMatcher = errors.New("invalid field matcher")
+)
+
+// Equals returns a FieldMatcher that is satisfied when the field is present and every value it selects is equal to
+// the value. Numbers are compared by value regardless of their type, as numbers read from the API server are int64 or
+// float64.
+func Equals(value any) FieldMatcher {
+	return everyValue(func(actual any) bool {
+		if actualNumber, ok := toFloat(actual); ok {
+			expectedNumber, ok := toFloat(value)
+
+			return ok && actualNumber == expectedNumber
+		}
+
+		return reflect.DeepEqual(actual, value)
+	})
+}
+
+// MatchesRegexp returns a FieldMatcher that is satisfied when the field is present and every value it selects matches
+// the regular expression. Values that are not strings are matched against their JSONPath output (e.g. "3" or "true").
+func MatchesRegexp(pattern string) FieldMatcher {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return FieldMatcher{match: nil, err: fmt.Errorf("%w: %w", ErrInvalidFieldMatcher, err)}
+	}
+
+	return everyValue(func(actual any) bool {
+		if str, ok := actual.(string); ok {
+			return re.MatchString(str)
+		}
+
+		return re.MatchString(fmt.Sprint(actual))
+	})
+}
+
+// IsPresent returns a FieldMatcher that is satisfied when the field is present, whatever its value.
+func IsPresent() FieldMatcher {
+	return FieldMatcher{match: func(values []any) bool { return len(values) > 0 }, err: nil}
+}
+
+// IsAbsent returns a FieldMatcher that is satisfied when the field is absent.
+func IsAbsent() FieldMatcher {
+	return FieldMatcher{match: func(values []any) bool { return len(values) == 0 }, err: nil}
+}
+
+// HasField asserts that exactly one resource that matches the provided options has a field, selected by the JSONPath
+// expression, that satisfies the matcher (e.g. HasField("{.spec.template.spec.serviceAccountName}", Equals("app"))).
+// The expression uses the same syntax as kubectl's -o jsonpath and the braces may be omitted.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) HasField(path string, matcher FieldMatcher) A {
+	return ra.ExactlyNHaveField(1, path, matcher)
+}
+
+// ExactlyNHaveField asserts that exactly N resources that match the provided options have a field, selected by the
+// JSONPath expression, that satisfies the matcher.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) ExactlyNHaveField(count int, path string, matcher FieldMatcher) A {
+	return ra.withFieldCheck("exactlyNHaveField", path, matcher, check[T]{quantifier: quantifierExactly, count: count})
+}
+
+// AtLeastNHaveField asserts that at least N resources that match the provided options have a field, selected by the
+// JSONPath expression, that satisfies the matcher.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AtLeastNHaveField(count int, path string, matcher FieldMatcher) A {
+	return ra.withFieldCheck("atLeastNHaveField", path, matcher, check[T]{quantifier: quantifierAtLeast, count: count})
+}
+
+// FieldMatches asserts that exactly one resource that matches the provided options has a field, selected by the
+// JSONPath expression, that matches the regular expression (see MatchesRegexp).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) FieldMatches(path, pattern string) A {
+	return ra.withFieldCheck(
+		"fieldMatches",
+		path,
+		MatchesRegexp(pattern),
+		check[T]{quantifier: quantifierExactly, count: 1},
+	)
+}
+
+// FieldAbsent asserts that exactly one resource that matches the provided options does not have the field selected by
+// the JSONPath expression.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) FieldAbsent(path string) A {
+	return ra.withFieldCheck("fieldAbsent", path, IsAbsent(), check[T]{quantifier: quantifierExactly, count: 1})
+}
+
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) withFieldCheck(stepName, path string, matcher FieldMatcher, chk check[T]) A {
+	chk.detail = jsonPathTemplate(path)
+	chk.predicate, chk.err = fieldPredicate[T](path, matcher)
+
+	return ra.withCheck(stepName, chk)
+}
+
+// fieldPredicate returns a Predicate that is satisfied by resources whose field, selected by the JSONPath expression,
+// satisfies the matcher.
+func fieldPredicate[T any](path string, matcher FieldMatcher) (Predicate[T], error) {
+	switch {
+	case matcher.err != nil:
+		return nil, matcher.err
+	case matcher.match == nil:
+		return nil, fmt.Errorf("%w: FieldMatcher must be created with Equals, MatchesRegexp, IsPresent or IsAbsent",
+			ErrInvalidFieldMatcher)
+	}
+
+	template := jsonPathTemplate(path)
+
+	// The expression is parsed for every resource as a parsed JSONPath holds state while it is evaluated.
+	if _, err := parseJSONPath(template); err != nil {
+		return nil, err
+	}
+
+	return func(item T) bool {
+		content, err := toUnstructuredContent(&item)
+		if err != nil {
+			return false
+		}
+
+		values, err := fieldValues(template, content)
+		if err != nil {
+			return false
+		}
+
+		return matcher.match(values)
+	}, nil
+}
+
+// fieldValues returns the values selected by the JSONPath template. Missing fields select no values.
+func fieldValues(template string, content map[string]any) ([]any, error) {
+	path, err := parseJSONPath(template)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := path.FindResults(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []any
+
+	for _, result := range results {
+		for _, value := range result {
+			if value.IsValid() && value.CanInterface() {
+				values = append(values, value.Interface())
+			}
+		}
+	}
+
+	return values, nil
+}
+
+func parseJSONPath(template string) (*jsonpath.JSONPath, error) {
+	path := jsonpath.New("field").AllowMissingKeys(true)
+
+	if err := path.Parse(template); err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrInvalidJSONPath, template, err)
+	}
+
+	return path, nil
+}
+
+// jsonPathTemplate adds the braces that client-go's jsonpath requires around an expression, as kubectl does.
+func jsonPathTemplate(path string) string {
+	path = strings.TrimSpace(path)
+	if strings.HasPrefix(path, "{") {
+		return path
+	}
+
+	if !strings.HasPrefix(path, ".") && !strings.HasPrefix(path, "$") {
+		path = "." + path
+	}
+
+	return "{" + path + "}"
+}
+
+// everyValue returns a FieldMatcher that is satisfied when the field is present and every value satisfies the
+// function.
+func everyValue(satisfies func(value any) bool) FieldMatcher {
+	return FieldMatcher{
+		match: func(values []any) bool {
+			if len(values) == 0 {
+				return false
+			}
+
+			for _, value := range values {
+				if !satisfies(value) {
+					return false
+				}
+			}
+
+			return true
+		},
+		err: nil,
+	}
+}
+
+func toFloat(value any) (float64, bool) {
+	number := reflect.ValueOf(value)
+
+	switch {
+	case number.CanInt():
+		return float64(number.Int()), true
+	case number.CanUint():
+		return float64(number.Uint()), true
+	case number.CanFloat():
+		return number.Float(), true
+	}
+
+	return 0, false
+}
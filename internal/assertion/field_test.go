@@ -0,0 +1,116 @@
+package assertion_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+)
+
+func TestHasField(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		assert deploymentAssertion
+		passed bool
+	}{
+		{
+			name: "Equals",
+			assert: newDeploymentAssertion(assertion.WithResourceName("frontend")).
+				HasField("{.spec.template.spec.priorityClassName}", assertion.Equals("system-cluster-critical")),
+			passed: true,
+		},
+		{
+			name: "Equals_WithoutBraces",
+			assert: newDeploymentAssertion(assertion.WithResourceName("frontend")).
+				HasField("metadata.labels.app", assertion.Equals("frontend")),
+			passed: true,
+		},
+		{
+			name: "Equals_Number",
+			assert: newDeploymentAssertion(assertion.WithResourceName("backend")).
+				HasField("{.spec.template.spec.containers[0].ports[0].containerPort}", assertion.Equals(8080)),
+			passed: true,
+		},
+		{
+			name: "Equals_Absent",
+			assert: newDeploymentAssertion(assertion.WithResourceName("backend")).
+				HasField("{.spec.template.spec.priorityClassName}", assertion.Equals("")),
+			passed: false,
+		},
+		{
+			name: "ExactlyNHaveField",
+			assert: newDeploymentAssertion().
+				ExactlyNHaveField(2, "{.spec.template.spec.containers[*].image}", assertion.Equals("nginx")),
+			passed: true,
+		},
+		{
+			name:   "AtLeastNHaveField",
+			assert: newDeploymentAssertion().AtLeastNHaveField(2, "{.metadata.labels.app}", assertion.IsPresent()),
+			passed: true,
+		},
+		{
+			name: "FieldMatches",
+			assert: newDeploymentAssertion(assertion.WithResourceName("backend")).
+				FieldMatches("{.spec.template.spec.containers[*].name}", "^back"),
+			passed: true,
+		},
+		{
+			name: "FieldMatches_NotAll",
+			assert: newDeploymentAssertion(assertion.WithResourceName("frontend")).
+				FieldMatches("{.metadata.labels.app}", "^back"),
+			passed: false,
+		},
+		{
+			name: "FieldAbsent",
+			assert: newDeploymentAssertion(assertion.WithResourceName("backend")).
+				FieldAbsent("{.spec.template.spec.priorityClassName}"),
+			passed: true,
+		},
+		{
+			name:   "FieldAbsent_Present",
+			assert: newDeploymentAssertion(assertion.WithResourceName("frontend")).FieldAbsent("{.metadata.labels}"),
+			passed: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.assert.EvaluateObjects(objs)
+			require.NoError(t, err)
+			require.Len(t, res.Checks, 1)
+			require.Equal(t, testCase.passed, res.Checks[0].Passed, res.String())
+		})
+	}
+}
+
+func TestHasField_Invalid(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	_, err = newDeploymentAssertion().HasField("{.spec[", assertion.IsPresent()).EvaluateObjects(objs)
+	require.ErrorIs(t, err, assertion.ErrInvalidJSONPath)
+
+	_, err = newDeploymentAssertion().FieldMatches("{.metadata.name}", "(").EvaluateObjects(objs)
+	require.ErrorIs(t, err, assertion.ErrInvalidFieldMatcher)
+
+	_, err = newDeploymentAssertion().HasField("{.metadata.name}", assertion.FieldMatcher{}).EvaluateObjects(objs)
+	require.ErrorIs(t, err, assertion.ErrInvalidFieldMatcher)
+}
+
+func TestHasField_CheckName(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	res, err := newDeploymentAssertion().
+		AtLeastNHaveField(1, ".metadata.name", assertion.IsPresent()).
+		EvaluateObjects(objs)
+	require.NoError(t, err)
+	require.Len(t, res.Checks, 1)
+	require.Equal(t, "atLeastNHaveField", res.Checks[0].Diagnostic.Check)
+	require.Equal(t, "{.metadata.name}", res.Checks[0].Diagnostic.Detail)
+}
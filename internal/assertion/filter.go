@@ -0,0 +1,66 @@
+package assertion
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+)
+
+// Filter returns a Predicate that narrows the resources selected by an assertion. It is called each time the resources
+// are listed, so it can depend on the state of the cluster (e.g. only select Events about the resources selected by
+// another assertion) when they cannot be selected by labels and fields alone.
+type Filter func(ctx context.Context, cfg *envconf.Config) (Predicate[unstructured.Unstructured], error)
+
+// FilteredBy narrows the resources selected by the assertion, for every check, to those that satisfy the Predicate
+// returned by the filter. Resources that do not satisfy it are ignored as if they did not match the provided options.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) FilteredBy(filter Filter) A {
+	res := ra.cloneResource()
+	res.filters = append(res.filters, filter)
+
+	return res.wrap(res)
+}
+
+// filterObjects returns the objects that satisfy every filter of the assertion.
+func (ra ResourceAssertion[T, A]) filterObjects(
+	ctx context.Context,
+	cfg *envconf.Config,
+	objs []unstructured.Unstructured,
+) ([]unstructured.Unstructured, error) {
+	if len(ra.filters) == 0 {
+		return objs, nil
+	}
+
+	predicates := make([]Predicate[unstructured.Unstructured], 0, len(ra.filters))
+
+	for _, filter := range ra.filters {
+		predicate, err := filter(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		predicates = append(predicates, predicate)
+	}
+
+	filtered := make([]unstructured.Unstructured, 0, len(objs))
+
+	for _, obj := range objs {
+		if satisfiesAll(obj, predicates) {
+			filtered = append(filtered, obj)
+		}
+	}
+
+	return filtered, nil
+}
+
+func satisfiesAll[T any](item T, predicates []Predicate[T]) bool {
+	for _, predicate := range predicates {
+		if !predicate(item) {
+			return false
+		}
+	}
+
+	return true
+}
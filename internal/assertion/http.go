@@ -0,0 +1,59 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/clients"
+)
+
+var (
+	// ErrInvalidPattern is returned when a pattern is not a valid regular expression.
+	ErrInvalidPattern = errors.New("invalid pattern")
+	// ErrProxyNotSupported is returned when the clients of a cluster cannot send requests through the API server proxy.
+	ErrProxyNotSupported = errors.New("proxying requests is not supported by the cluster")
+)
+
+// RespondsHTTP returns a ClusterPredicate that sends a GET request for the path to the port (a name or number) of each
+// resource through the proxy subresource of the API server. The resource is identified by its plural name (i.e. "pods"
+// or "services"). It is satisfied by resources that respond with the status code and a body that contains a match for
+// the regular expression, which matches any body when it is empty. Resources for which no response is received, or for
+// which the API server rejects the request (e.g. it is forbidden), are reported as errors rather than as a status code
+// that does not match.
+func RespondsHTTP[T any](resource, path, port string, status int, bodyPattern string) ClusterPredicate[T] {
+	re, compileErr := regexp.Compile(bodyPattern)
+
+	return func(_ context.Context, cfg *envconf.Config) (ContextPredicate[T], error) {
+		if compileErr != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidPattern, bodyPattern, compileErr)
+		}
+
+		shared, err := clients.ForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if shared.Proxy == nil {
+			return nil, ErrProxyNotSupported
+		}
+
+		return func(ctx context.Context, item T) (bool, error) {
+			obj, err := meta.Accessor(&item)
+			if err != nil {
+				return false, err
+			}
+
+			statusCode, body, err := shared.Proxy.ProxyGet(ctx, resource, obj.GetNamespace(), obj.GetName(), port, path)
+			if err != nil {
+				return false, fmt.Errorf("requesting %s on port %q: %w", path, port, err)
+			}
+
+			return statusCode == status && re.Match(body), nil
+		}, nil
+	}
+}
@@ -1,6 +1,8 @@
 package assertion
 
 import (
+	"maps"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -11,17 +13,25 @@ type listOptionsFunc func(*commonAssertion, *envconf.Config) metav1.ListOptions
 
 func defaultListOptions(ca *commonAssertion, _ *envconf.Config) metav1.ListOptions {
 	return metav1.ListOptions{
-		LabelSelector: labels.SelectorFromSet(labels.Set(ca.assertLabels)).String(),
+		LabelSelector: labelSelector(ca).String(),
 		FieldSelector: fields.SelectorFromSet(fields.Set(ca.assertFields)).String(),
 	}
 }
 
+// listOptionsWithNamespaceFromEnv selects resources in the namespace of the test environment. When it has no namespace
+// (e.g. when an assertion is evaluated outside of a test), only resources without a namespace are selected rather than
+// resources in every namespace.
 func listOptionsWithNamespaceFromEnv(ca *commonAssertion, cfg *envconf.Config) metav1.ListOptions {
-	selectorFields := fields.Set(ca.assertFields)
+	selectorFields := fields.Set{}
+	maps.Copy(selectorFields, ca.assertFields)
 	selectorFields["metadata.namespace"] = cfg.Namespace()
 
 	return metav1.ListOptions{
-		LabelSelector: labels.SelectorFromSet(labels.Set(ca.assertLabels)).String(),
+		LabelSelector: labelSelector(ca).String(),
 		FieldSelector: fields.SelectorFromSet(selectorFields).String(),
 	}
 }
+
+func labelSelector(ca *commonAssertion) labels.Selector {
+	return labels.SelectorFromSet(labels.Set(ca.assertLabels)).Add(ca.labelRequirements...)
+}
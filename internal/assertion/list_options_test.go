@@ -0,0 +1,57 @@
+package assertion_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+)
+
+func TestListOptions_NamespaceFromTestEnv(t *testing.T) {
+	testCases := []struct {
+		name          string
+		cfg           *envconf.Config
+		fieldSelector string
+	}{
+		{
+			name:          "Namespace",
+			cfg:           envconf.New().WithNamespace("apps"),
+			fieldSelector: "metadata.name=app,metadata.namespace=apps",
+		},
+		{
+			// Outside of a test (e.g. with Evaluate), resources in every namespace must not be selected.
+			name:          "NoNamespace",
+			cfg:           envconf.New(),
+			fieldSelector: "metadata.name=app,metadata.namespace=",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := newDeploymentAssertion(
+				assertion.WithResourceName("app"),
+				assertion.WithResourceNamespaceFromTestEnv(),
+			)
+
+			// The terms of a field selector are not ordered.
+			require.ElementsMatch(
+				t,
+				strings.Split(tc.fieldSelector, ","),
+				strings.Split(assert.ListOptions(tc.cfg).FieldSelector, ","),
+			)
+			require.Equal(t, map[string]string{"metadata.name": "app"}, assert.GetFields())
+		})
+	}
+}
+
+func TestWithLabelSelector_Invalid(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	_, err = newDeploymentAssertion(assertion.WithLabelSelector("tier in (frontend")).Exists().EvaluateObjects(objs)
+	require.ErrorIs(t, err, assertion.ErrInvalidLabelSelector)
+}
@@ -0,0 +1,125 @@
+package assertion
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+	"sigs.k8s.io/e2e-framework/pkg/features"
+
+	"github.com/DWSR/kubeassert-go/internal/clients"
+)
+
+// NewMappedResourceAssertion creates a new ResourceAssertion for resources that are not known until the assertion is
+// evaluated (e.g. custom resources). Either the resource or the kind is set and the other is empty. The resource, and
+// whether it is namespaced, are resolved with the RESTMapper of the cluster so that namespaced resources are listed
+// in the selected namespace (e.g. with WithResourceNamespace) rather than across all namespaces.
+func NewMappedResourceAssertion[T any, A any](
+	resource schema.GroupVersionResource,
+	kind schema.GroupVersionKind,
+	wrap func(ResourceAssertion[T, A]) A,
+	builder *features.FeatureBuilder,
+	opts ...Option,
+) ResourceAssertion[T, A] {
+	res := NewResourceAssertion(resource, wrap, builder, opts...)
+	res.kind = kind
+	res.mapped = true
+
+	return res
+}
+
+// Kind returns the GroupVersionKind of the resources selected by the assertion, if it was created for a kind.
+func (ra ResourceAssertion[T, A]) Kind() schema.GroupVersionKind {
+	return ra.kind
+}
+
+// KindName returns the name of the kind of the resources selected by the assertion (e.g. "Deployment"), or the resource
+// (e.g. "widgets") for mapped assertions created for a resource. Unlike the
+// name of the Feature, which can be changed with WithBuilder, it only depends on the resources, so it is used to label
+// reports and metrics.
+func (ra ResourceAssertion[T, A]) KindName() string {
+	if !ra.kind.Empty() {
+		return ra.kind.Kind
+	}
+
+	if typ := reflect.TypeFor[T](); typ != reflect.TypeFor[unstructured.Unstructured]() {
+		return typ.Name()
+	}
+
+	return ra.resource.Resource
+}
+
+// resourceClient returns the client used to list and watch the selected resources along with their
+// GroupVersionResource. Mapped resources in a namespace are listed in the namespace.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) resourceClient(
+	cfg *envconf.Config,
+	listOpts metav1.ListOptions,
+) (dynamic.ResourceInterface, schema.GroupVersionResource, error) {
+	shared, err := clients.ForConfig(cfg)
+	if err != nil {
+		return nil, ra.resource, err
+	}
+
+	if !ra.mapped {
+		return shared.Dynamic.Resource(ra.resource), ra.resource, nil
+	}
+
+	mapping, err := ra.restMapping(shared.Mapper)
+	if err != nil {
+		// The resource may be served later (e.g. once a CRD is applied), so discovery is refreshed before the next
+		// attempt.
+		if meta.IsNoMatchError(err) {
+			shared.Mapper.Reset()
+		}
+
+		return nil, ra.resource, err
+	}
+
+	client := shared.Dynamic.Resource(mapping.Resource)
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		selector, err := fields.ParseSelector(listOpts.FieldSelector)
+		if err != nil {
+			return nil, mapping.Resource, err
+		}
+
+		if namespace, found := selector.RequiresExactMatch("metadata.namespace"); found {
+			return client.Namespace(namespace), mapping.Resource, nil
+		}
+	}
+
+	return client, mapping.Resource, nil
+}
+
+func (ra ResourceAssertion[T, A]) restMapping(mapper meta.RESTMapper) (*meta.RESTMapping, error) {
+	kind := ra.kind
+
+	if kind.Empty() {
+		var err error
+
+		kind, err = mapper.KindFor(ra.resource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return mapper.RESTMapping(kind.GroupKind(), kind.Version)
+}
+
+// selectsKind returns true if objects of the kind are selected by the assertion.
+func (ra ResourceAssertion[T, A]) selectsKind(kind schema.GroupVersionKind) bool {
+	if !ra.kind.Empty() {
+		return kind == ra.kind
+	}
+
+	plural, _ := meta.UnsafeGuessKindToResource(kind)
+
+	return plural == ra.resource
+}
@@ -0,0 +1,329 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apimachinerywait "k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+	e2etypes "sigs.k8s.io/e2e-framework/pkg/types"
+)
+
+type (
+	// Monitor evaluates the checks of an assertion in the background while other steps of a Feature run. It is started
+	// by the step returned by Start (typically added with WithSetup) and stopped by the step returned by Stop (typically
+	// added with WithTeardown), which fails the test if any check was violated while the Monitor was running. A check
+	// that cannot be evaluated for several consecutive attempts (e.g. because the API server is unavailable) is
+	// considered violated, and one that was never evaluated fails the Monitor.
+	//
+	// For example, the following asserts that at least 2 coredns replicas stay available while a new version of a
+	// kustomization is applied by a setup step:
+	//
+	//	monitor := NewDeploymentAssertion(
+	//		WithResourceNamespace("kube-system"),
+	//		WithResourceName("coredns"),
+	//	).AtLeastNAreAvailable(2).Monitor()
+	//
+	//	applyV2 := func(ctx context.Context, t *testing.T, cfg *envconf.Config) context.Context {
+	//		ctx, err := ApplyKustomization("./v2")(ctx, cfg)
+	//		require.NoError(t, err)
+	//
+	//		return ctx
+	//	}
+	//
+	//	NewDeploymentAssertion(
+	//		WithResourceNamespace("kube-system"),
+	//		WithResourceName("coredns"),
+	//		WithSetup(monitor.Start(), applyV2),
+	//		WithTeardown(monitor.Stop()),
+	//	).IsAvailable()
+	Monitor struct {
+		requireT require.TestingT
+		checks   []monitoredCheck
+
+		mu         sync.Mutex
+		started    time.Time
+		cancel     context.CancelFunc
+		running    sync.WaitGroup
+		violations []Violation
+		err        error
+	}
+
+	// Violation is a period of time during which a monitored check was not satisfied.
+	Violation struct {
+		// Start is when the check was first observed to be violated.
+		Start time.Time
+		// End is when the check was next observed to be satisfied. It is zero if the check was still violated when the
+		// Monitor stopped.
+		End time.Time
+		// Diagnostic is the state observed when the violation started.
+		Diagnostic Diagnostic
+	}
+
+	// monitoredCheck is a check evaluated by a Monitor.
+	monitoredCheck struct {
+		// diag describes the check before it is evaluated.
+		diag Diagnostic
+		run  func(ctx context.Context, cfg *envconf.Config, observer *checkObserver) error
+	}
+
+	// checkObserver records the violations of a single check on its Monitor.
+	checkObserver struct {
+		monitor *Monitor
+		diag    Diagnostic
+		// open is the index of the ongoing violation or -1 when the check is satisfied.
+		open int
+		// evaluated is whether the check was evaluated at least once.
+		evaluated bool
+		// failures is the number of consecutive attempts to evaluate the check that failed with a retryable error.
+		failures int
+	}
+)
+
+// persistentFailures is the number of consecutive attempts to evaluate a check that must fail with retryable errors
+// for the check to be considered violated, so that a single throttled request does not fail a Monitor.
+const persistentFailures = 3
+
+var (
+	// ErrMonitorViolated is returned by a Monitor when one or more of its checks were violated while it was running.
+	ErrMonitorViolated = errors.New("monitored checks were violated")
+	// ErrMonitorNotEvaluated is returned by a Monitor when one of its checks was never evaluated while it was running
+	// (e.g. because the API server was unavailable throughout).
+	ErrMonitorNotEvaluated = errors.New("monitored check was never evaluated")
+)
+
+// Monitor returns a Monitor that evaluates the checks added to the assertion so far in the background. The checks are
+// evaluated at the assertion's interval, or on every change when watching, until the Monitor is stopped. The
+// assertion's timeout and Consistently windows are ignored.
+func (ra ResourceAssertion[T, A]) Monitor() *Monitor {
+	monitor := &Monitor{requireT: ra.GetRequireT()}
+
+	for _, chk := range ra.checks {
+		monitor.checks = append(monitor.checks, monitoredCheck{
+			diag: newDiagnosticRecorder(chk).last(),
+			run: func(ctx context.Context, cfg *envconf.Config, obs *checkObserver) error {
+				return ra.monitorCheck(ctx, cfg, chk, obs)
+			},
+		})
+	}
+
+	return monitor
+}
+
+// monitorCheck evaluates the check until ctx is done, passing every observed state to the observer.
+func (ra ResourceAssertion[T, A]) monitorCheck(
+	ctx context.Context,
+	cfg *envconf.Config,
+	chk check[T],
+	observer *checkObserver,
+) error {
+	chk, err := chk.resolve(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Never report the check as done so that it is evaluated until the Monitor is stopped.
+	evaluate := func(ctx context.Context, items []T) bool {
+		// Bound the evaluation, which may query the cluster, as ctx is only done once the Monitor is stopped.
+		ctx, cancel := context.WithTimeout(ctx, ra.GetTimeout())
+		defer cancel()
+
+		observer.observe(chk.evaluate(ctx, items))
+
+		return false
+	}
+
+	if ra.GetWatch() {
+		err = ra.waitWithWatch(ctx, cfg, 0, evaluate, observer.observeErr)
+	} else {
+		err = apimachinerywait.PollUntilContextCancel(
+			ctx,
+			ra.GetInterval(),
+			true,
+			ra.conditionFunc(cfg, evaluate, observer.observeErr),
+		)
+	}
+
+	if ctx.Err() != nil && (err == nil || apimachinerywait.Interrupted(err)) {
+		return nil
+	}
+
+	return err
+}
+
+// Start returns a step that starts the Monitor. The checks are evaluated in the background until the step returned by
+// Stop runs. Starting a Monitor discards the violations recorded by any previous run.
+func (m *Monitor) Start() e2etypes.StepFunc {
+	return func(ctx context.Context, _ *testing.T, cfg *envconf.Config) context.Context {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		monitorCtx, cancel := context.WithCancel(ctx)
+
+		m.started = time.Now()
+		m.cancel = cancel
+		m.violations = nil
+		m.err = nil
+
+		for _, monitorCheck := range m.checks {
+			observer := &checkObserver{monitor: m, diag: monitorCheck.diag, open: -1}
+
+			m.running.Add(1)
+
+			go func() {
+				defer m.running.Done()
+
+				if err := monitorCheck.run(monitorCtx, cfg, observer); err != nil {
+					m.fail(err)
+
+					return
+				}
+
+				observer.done()
+			}()
+		}
+
+		return ctx
+	}
+}
+
+// Stop returns a step that stops the Monitor and fails the test if any of its checks were violated, or could not be
+// evaluated, while it was running. The failure message contains a timeline of the violations.
+func (m *Monitor) Stop() e2etypes.StepFunc {
+	return func(ctx context.Context, testingT *testing.T, _ *envconf.Config) context.Context {
+		t := requireTIfNotNil(testingT, m.requireT)
+
+		m.mu.Lock()
+		cancel := m.cancel
+		m.mu.Unlock()
+
+		if cancel != nil {
+			cancel()
+		}
+
+		m.running.Wait()
+
+		require.NoError(t, m.Err(), m.String())
+
+		return ctx
+	}
+}
+
+// Violations returns the violations recorded by the Monitor in the order in which they started.
+func (m *Monitor) Violations() []Violation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Violation(nil), m.violations...)
+}
+
+// Err returns the error that stopped the Monitor, or ErrMonitorViolated if any of its checks were violated.
+func (m *Monitor) Err() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+
+	if len(m.violations) > 0 {
+		return fmt.Errorf("%w: %d violation(s)", ErrMonitorViolated, len(m.violations))
+	}
+
+	return nil
+}
+
+// String returns a timeline of the violations recorded by the Monitor. Times are relative to when it was started.
+func (m *Monitor) String() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "monitor recorded %d violation(s)", len(m.violations))
+
+	for _, violation := range m.violations {
+		end := "still violated when stopped"
+		if !violation.End.IsZero() {
+			end = "until +" + violation.End.Sub(m.started).Round(time.Millisecond).String()
+		}
+
+		fmt.Fprintf(
+			&builder,
+			"\n+%s (%s): %s",
+			violation.Start.Sub(m.started).Round(time.Millisecond),
+			end,
+			strings.ReplaceAll(violation.Diagnostic.String(), "\n", "\n  "),
+		)
+	}
+
+	return builder.String()
+}
+
+func (m *Monitor) fail(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err == nil {
+		m.err = err
+	}
+}
+
+// observe opens a violation when the check stops being satisfied and closes it when the check is satisfied again.
+func (o *checkObserver) observe(satisfied bool, diag Diagnostic) {
+	o.monitor.mu.Lock()
+	defer o.monitor.mu.Unlock()
+
+	o.evaluated = true
+	o.failures = 0
+
+	switch {
+	case !satisfied && o.open < 0:
+		o.open = len(o.monitor.violations)
+		o.monitor.violations = append(o.monitor.violations, Violation{Start: time.Now(), Diagnostic: diag})
+	case satisfied && o.open >= 0:
+		o.monitor.violations[o.open].End = time.Now()
+		o.open = -1
+	}
+}
+
+// observeErr records errors that are not retryable so that they are reported when the Monitor is stopped. Retryable
+// errors open a violation, with the error as its diagnostic, once they persist because the state of the check is then
+// unknown.
+func (o *checkObserver) observeErr(err error) {
+	if !IsRetryable(err) {
+		o.monitor.fail(err)
+
+		return
+	}
+
+	o.monitor.mu.Lock()
+	defer o.monitor.mu.Unlock()
+
+	o.failures++
+
+	if o.failures >= persistentFailures && o.open < 0 {
+		diag := o.diag
+		diag.Err = err
+
+		o.open = len(o.monitor.violations)
+		o.monitor.violations = append(o.monitor.violations, Violation{Start: time.Now(), Diagnostic: diag})
+	}
+}
+
+// done fails the Monitor if the check was never evaluated while it was running.
+func (o *checkObserver) done() {
+	o.monitor.mu.Lock()
+	evaluated := o.evaluated
+	o.monitor.mu.Unlock()
+
+	if !evaluated {
+		o.monitor.fail(fmt.Errorf("%w: %q", ErrMonitorNotEvaluated, o.diag.Check))
+	}
+}
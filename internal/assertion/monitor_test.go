@@ -0,0 +1,39 @@
+package assertion_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/testhelpers"
+)
+
+func TestMonitor_Unavailable(t *testing.T) {
+	cfg := newUnavailableConfig(t)
+	mockT := &testhelpers.MockT{}
+
+	monitor := newDeploymentAssertion(
+		assertion.WithRequireT(mockT),
+		assertion.WithInterval(10*time.Millisecond),
+	).NoneExist().Monitor()
+
+	ctx := monitor.Start()(context.Background(), t, cfg)
+
+	time.Sleep(100 * time.Millisecond)
+
+	monitor.Stop()(ctx, t, cfg)
+
+	require.True(t, mockT.Failed)
+	require.ErrorIs(t, monitor.Err(), assertion.ErrMonitorNotEvaluated)
+	require.Contains(t, strings.Join(mockT.Errors, "\n"), "upgrading")
+
+	violations := monitor.Violations()
+	require.Len(t, violations, 1)
+	require.Equal(t, "noneExist", violations[0].Diagnostic.Check)
+	require.Error(t, violations[0].Diagnostic.Err)
+	require.True(t, violations[0].End.IsZero())
+}
@@ -0,0 +1,106 @@
+package assertion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+)
+
+// ObjectEvaluator is implemented by assertions that can be evaluated against a set of objects instead of a cluster.
+type ObjectEvaluator interface {
+	// EvaluateObjects evaluates the checks of the assertion against the supplied objects.
+	EvaluateObjects(objs []unstructured.Unstructured) (Result, error)
+}
+
+// EvaluateObjects evaluates the checks of the assertion against the supplied objects (e.g. manifests rendered from a
+// kustomization) instead of the resources in a cluster. This makes it possible to catch violations of checks that only
+// depend on the spec of resources (e.g. HasCPURequests) before anything is deployed.
+//
+// The objects are selected in the same way as resources in a cluster: by resource, labels and fields. Each check is
+// evaluated once, so the timeout, interval, watch and Consistently settings are ignored, and checks that depend on the
+// status of resources (e.g. IsAvailable) are not satisfied as rendered objects do not have one.
+func (ra ResourceAssertion[T, A]) EvaluateObjects(objs []unstructured.Unstructured) (Result, error) {
+	cfg := envconf.New()
+
+	selected, err := selectObjects(ra.selectsKind, ra.ListOptions(cfg), objs)
+	if err != nil {
+		return Result{}, err
+	}
+
+	selected, err = ra.filterObjects(context.Background(), cfg, selected)
+	if err != nil {
+		return Result{}, err
+	}
+
+	items, err := fromUnstructured[T](selected)
+	if err != nil {
+		return Result{}, err
+	}
+
+	res := Result{Checks: make([]CheckResult, 0, len(ra.checks))}
+
+	for _, chk := range ra.checks {
+		if chk.err != nil {
+			return res, chk.err
+		}
+
+		if chk.clusterPredicate != nil {
+			return res, fmt.Errorf("%w: %q", ErrClusterRequired, chk.name)
+		}
+
+		start := time.Now()
+		passed, diag := chk.evaluate(context.Background(), items)
+
+		ra.report(cfg, start, passed, diag)
+
+		res.Checks = append(res.Checks, CheckResult{Passed: passed, Diagnostic: diag})
+	}
+
+	return res, nil
+}
+
+// selectObjects returns the objects of the selected kinds that match the label and field selectors of the list
+// options, mirroring how the API server selects resources.
+func selectObjects(
+	selectsKind func(schema.GroupVersionKind) bool,
+	opts metav1.ListOptions,
+	objs []unstructured.Unstructured,
+) ([]unstructured.Unstructured, error) {
+	labelSelector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldSelector, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []unstructured.Unstructured
+
+	for _, obj := range objs {
+		if !selectsKind(obj.GroupVersionKind()) {
+			continue
+		}
+
+		matches, err := manifests.Matches(obj, labelSelector, fieldSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		if matches {
+			selected = append(selected, obj)
+		}
+	}
+
+	return selected, nil
+}
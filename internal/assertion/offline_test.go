@@ -0,0 +1,142 @@
+package assertion_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+	"sigs.k8s.io/e2e-framework/pkg/features"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+	"github.com/DWSR/kubeassert-go/internal/report"
+)
+
+type deploymentAssertion struct {
+	assertion.ResourceAssertion[appsv1.Deployment, deploymentAssertion]
+}
+
+func newDeploymentAssertion(opts ...assertion.Option) deploymentAssertion {
+	return deploymentAssertion{
+		ResourceAssertion: assertion.NewResourceAssertion(
+			appsv1.SchemeGroupVersion.WithResource("deployments"),
+			func(ra assertion.ResourceAssertion[appsv1.Deployment, deploymentAssertion]) deploymentAssertion {
+				return deploymentAssertion{ResourceAssertion: ra}
+			},
+			features.New("Deployment"),
+			opts...,
+		),
+	}
+}
+
+func isSystemClusterCritical(deploy appsv1.Deployment) bool {
+	return deploy.Spec.Template.Spec.PriorityClassName == "system-cluster-critical"
+}
+
+func TestEvaluateObjects(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		assert deploymentAssertion
+		passed []bool
+	}{
+		{
+			name:   "AllDeployments",
+			assert: newDeploymentAssertion().ExactlyNExist(2).AtLeastNMatch("critical", 1, isSystemClusterCritical),
+			passed: []bool{true, true},
+		},
+		{
+			name:   "LabelSelector",
+			assert: newDeploymentAssertion(assertion.WithLabelSelector("app=backend")).Exists(),
+			passed: []bool{true},
+		},
+		{
+			name: "FieldSelector",
+			assert: newDeploymentAssertion(assertion.WithResourceNamespace("web")).
+				ExactlyNMatch("critical", 1, isSystemClusterCritical),
+			passed: []bool{true},
+		},
+		{
+			name:   "Unsatisfied",
+			assert: newDeploymentAssertion(assertion.WithResourceName("backend")).NoneExist(),
+			passed: []bool{false},
+		},
+		{
+			name:   "NoMatch",
+			assert: newDeploymentAssertion(assertion.WithResourceNamespace("missing")).NoneExist(),
+			passed: []bool{true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.assert.EvaluateObjects(objs)
+			require.NoError(t, err)
+			require.Len(t, res.Checks, len(testCase.passed))
+
+			for i, passed := range testCase.passed {
+				require.Equal(t, passed, res.Checks[i].Passed, res.String())
+			}
+		})
+	}
+}
+
+func TestEvaluateObjects_ClusterRequired(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	inCluster := func(context.Context, *envconf.Config) (assertion.ContextPredicate[appsv1.Deployment], error) {
+		return func(_ context.Context, deploy appsv1.Deployment) (bool, error) {
+			return isSystemClusterCritical(deploy), nil
+		}, nil
+	}
+
+	_, err = newDeploymentAssertion().WhereInCluster("critical", inCluster).EvaluateObjects(objs)
+	require.ErrorIs(t, err, assertion.ErrClusterRequired)
+}
+
+func TestEvaluateObjects_ReportsKind(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	reporter := report.NewReporter()
+
+	_, err = newDeploymentAssertion(
+		assertion.WithBuilder(features.New("backend is deployed")),
+		assertion.WithReporter(reporter),
+	).ExactlyNExist(2).EvaluateObjects(objs)
+	require.NoError(t, err)
+
+	records := reporter.Records()
+	require.Len(t, records, 1)
+	require.Equal(t, "Deployment", records[0].Kind)
+	require.Equal(t, "backend is deployed", records[0].Feature)
+}
+
+func TestKindName(t *testing.T) {
+	wrap := func(ra assertion.ResourceAssertion[unstructured.Unstructured, any]) any { return ra }
+	widgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+	require.Equal(t, "Deployment", newDeploymentAssertion().KindName())
+	require.Equal(
+		t,
+		"Widget",
+		assertion.NewMappedResourceAssertion(
+			schema.GroupVersionResource{},
+			widgets.GroupVersion().WithKind("Widget"),
+			wrap,
+			features.New("widgets"),
+		).KindName(),
+	)
+	require.Equal(
+		t,
+		"widgets",
+		assertion.NewMappedResourceAssertion(widgets, schema.GroupVersionKind{}, wrap, features.New("widgets")).KindName(),
+	)
+}
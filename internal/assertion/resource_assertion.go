@@ -0,0 +1,517 @@
+package assertion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apimachinerywait "k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+	"sigs.k8s.io/e2e-framework/pkg/features"
+
+	"github.com/DWSR/kubeassert-go/internal/report"
+)
+
+type (
+	// ResourceAssertion is a generic Assertion about resources of a single kind. T is the Go type that resources are
+	// converted to (e.g. appsv1.Deployment) and A is the kind-specific assertion type that embeds the ResourceAssertion
+	// (e.g. deployments.DeploymentAssertion). Returning A from every method lets kind-specific assertions chain the
+	// generic methods with their own.
+	//
+	// ResourceAssertion owns listing resources from the API server, converting them to T and comparing the number of
+	// resources that satisfy a Predicate against the expected count, so that a kind-specific assertion only needs to
+	// supply its Predicates.
+	ResourceAssertion[T any, A any] struct {
+		Assertion
+
+		resource schema.GroupVersionResource
+		// kind is set instead of resource for mapped assertions created for a kind.
+		kind schema.GroupVersionKind
+		// mapped resolves the resource and its scope with the RESTMapper when the assertion is evaluated.
+		mapped       bool
+		wrap         func(ResourceAssertion[T, A]) A
+		consistently time.Duration
+		// checks contains every check added to the assertion so that they can be evaluated outside of the Feature.
+		checks []check[T]
+		// filters narrow the selected resources when the assertion is evaluated.
+		filters []Filter
+	}
+
+	// Predicate reports whether a single resource satisfies a condition.
+	Predicate[T any] func(T) bool
+
+	// quantifier determines how the number of resources under consideration is compared to the expected count.
+	quantifier int
+
+	// check is a single condition evaluated against the resources selected by a ResourceAssertion.
+	check[T any] struct {
+		name string
+		// detail describes what the check evaluates when its name does not (e.g. a CEL expression). It is shown in
+		// Diagnostics and recorded on spans but, unlike the name, is not used as a metric label as it is unbounded.
+		detail     string
+		quantifier quantifier
+		count      int
+		// predicate is nil when only the number of selected resources matters.
+		predicate Predicate[T]
+		// fallible is set instead of predicate when evaluating a resource can fail (e.g. a CEL expression that refers to
+		// a field that is not set, or a request to the cluster). The check is not satisfied while a resource cannot be
+		// evaluated.
+		fallible ContextPredicate[T]
+		// clusterPredicate, if set, returns the predicate when the check is evaluated against a cluster.
+		clusterPredicate ClusterPredicate[T]
+		// onlySatisfying compares only the number of resources that satisfy the predicate with the expected count,
+		// regardless of the number of selected resources.
+		onlySatisfying bool
+		// consistently is the window for which the check must hold. When zero, the check must eventually be satisfied.
+		consistently time.Duration
+		// err is set when the check could not be created (e.g. from an invalid CEL expression) and is returned instead
+		// of evaluating the check.
+		err error
+	}
+)
+
+const (
+	quantifierExactly quantifier = iota
+	quantifierAtLeast
+	quantifierNone
+	// quantifierAll is satisfied when at least one resource is under consideration and every one satisfies the
+	// predicate.
+	quantifierAll
+)
+
+func (q quantifier) satisfied(actual, expected int) bool {
+	switch q {
+	case quantifierAtLeast:
+		return actual >= expected
+	case quantifierNone:
+		return actual == 0
+	case quantifierExactly:
+		return actual == expected
+	case quantifierAll:
+		return false
+	}
+
+	return false
+}
+
+func (q quantifier) describe(count int) string {
+	switch q {
+	case quantifierAtLeast:
+		return fmt.Sprintf("at least %d", count)
+	case quantifierNone:
+		return "none"
+	case quantifierExactly:
+		return fmt.Sprintf("exactly %d", count)
+	case quantifierAll:
+		return "all"
+	}
+
+	return ""
+}
+
+// evaluate returns true if the supplied items satisfy the check along with a Diagnostic describing the observed state.
+// When a predicate is set, the number of items must satisfy the quantifier in addition to the number of items that
+// satisfy the predicate.
+func (c check[T]) evaluate(ctx context.Context, items []T) (bool, Diagnostic) {
+	diag := Diagnostic{
+		Check:    c.name,
+		Detail:   c.detail,
+		Expected: c.quantifier.describe(c.count),
+		Selected: len(items),
+		Objects:  make([]ObjectDiagnostic, 0, len(items)),
+	}
+
+	undetermined := false
+
+	for _, item := range items {
+		satisfied, err := c.test(ctx, item)
+		if satisfied {
+			diag.Satisfied++
+		}
+
+		obj := objectDiagnostic(&item, satisfied)
+		obj.Err = err
+		undetermined = undetermined || err != nil
+
+		diag.Objects = append(diag.Objects, obj)
+	}
+
+	if c.predicate == nil && c.fallible == nil {
+		return c.quantifier.satisfied(diag.Selected, c.count), diag
+	}
+
+	// The check cannot be decided while the state of a resource is unknown, regardless of the quantifier (e.g. a
+	// resource that cannot be evaluated must not count as not satisfying the predicate of NoneWhereCEL).
+	if undetermined {
+		return false, diag
+	}
+
+	if c.quantifier == quantifierAll {
+		return diag.Selected > 0 && diag.Satisfied == diag.Selected, diag
+	}
+
+	// quantifierNone only concerns the items that satisfy the predicate, so any number of items is acceptable.
+	if !c.onlySatisfying && c.quantifier != quantifierNone && !c.quantifier.satisfied(diag.Selected, c.count) {
+		return false, diag
+	}
+
+	return c.quantifier.satisfied(diag.Satisfied, c.count), diag
+}
+
+// test returns true if the item satisfies the predicate of the check, or an error if it cannot be evaluated.
+func (c check[T]) test(ctx context.Context, item T) (bool, error) {
+	switch {
+	case c.fallible != nil:
+		return c.fallible(ctx, item)
+	case c.predicate != nil:
+		return c.predicate(item), nil
+	}
+
+	return true, nil
+}
+
+func objectDiagnostic(item any, satisfied bool) ObjectDiagnostic {
+	res := ObjectDiagnostic{Satisfied: satisfied}
+
+	if obj, err := meta.Accessor(item); err == nil {
+		res.Namespace = obj.GetNamespace()
+		res.Name = obj.GetName()
+	}
+
+	return res
+}
+
+// Resource returns the GroupVersionResource of the resources selected by the assertion. It is empty for mapped
+// assertions created for a kind.
+func (ra ResourceAssertion[T, A]) Resource() schema.GroupVersionResource {
+	return ra.resource
+}
+
+// List lists the resources that match the assertion's options and converts them to T.
+func (ra ResourceAssertion[T, A]) List(ctx context.Context, cfg *envconf.Config) ([]T, error) {
+	objs, err := ra.ListObjects(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromUnstructured[T](objs)
+}
+
+// ListObjects lists the resources that match the assertion's options as unstructured objects.
+func (ra ResourceAssertion[T, A]) ListObjects(
+	ctx context.Context,
+	cfg *envconf.Config,
+) ([]unstructured.Unstructured, error) {
+	listOpts := ra.ListOptions(cfg)
+
+	client, resource, err := ra.resourceClient(cfg, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := ra.listResources(ctx, client, resource, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return ra.filterObjects(ctx, cfg, list.Items)
+}
+
+// Exists asserts that exactly one resource exists in the cluster that matches the provided options.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) Exists() A {
+	return ra.ExactlyNExist(1)
+}
+
+// ExactlyNExist asserts that exactly N resources exist in the cluster that match the provided options.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) ExactlyNExist(count int) A {
+	return ra.withCheck("exactlyNExist", check[T]{quantifier: quantifierExactly, count: count})
+}
+
+// AtLeastNExist asserts that at least N resources exist in the cluster that match the provided options.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AtLeastNExist(count int) A {
+	return ra.withCheck("atLeastNExist", check[T]{quantifier: quantifierAtLeast, count: count})
+}
+
+// NoneExist asserts that no resources exist in the cluster that match the provided options.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) NoneExist() A {
+	return ra.withCheck("noneExist", check[T]{quantifier: quantifierNone})
+}
+
+// IsDeleted asserts that the resources that match the provided options have been deleted from the cluster. It is
+// equivalent to NoneExist but reads better after a step that removes resources (e.g. an upgrade).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) IsDeleted() A {
+	return ra.withCheck("isDeleted", check[T]{quantifier: quantifierNone})
+}
+
+// ExactlyNMatch asserts that exactly N resources exist in the cluster that match the provided options and that exactly
+// N of them satisfy the predicate. The stepName is used to name the step in the e2e-framework Feature.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) ExactlyNMatch(stepName string, count int, predicate Predicate[T]) A {
+	return ra.withCheck(stepName, check[T]{quantifier: quantifierExactly, count: count, predicate: predicate})
+}
+
+// AtLeastNMatch asserts that at least N resources exist in the cluster that match the provided options and that at
+// least N of them satisfy the predicate. The stepName is used to name the step in the e2e-framework Feature.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AtLeastNMatch(stepName string, count int, predicate Predicate[T]) A {
+	return ra.withCheck(stepName, check[T]{quantifier: quantifierAtLeast, count: count, predicate: predicate})
+}
+
+// NoneMatch asserts that none of the resources in the cluster that match the provided options satisfy the predicate.
+// This is also satisfied when no resources match the provided options. The stepName is used to name the step in the
+// e2e-framework Feature.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) NoneMatch(stepName string, predicate Predicate[T]) A {
+	return ra.withCheck(stepName, check[T]{quantifier: quantifierNone, predicate: predicate})
+}
+
+// Where asserts that at least one resource exists in the cluster that matches the provided options and that every one
+// of them satisfies the predicate. It enables rules that are not covered by the kind-specific assertions (e.g. that
+// every Deployment sets a team label) to be polled and diagnosed in the same way. The name is used to name the step in
+// the e2e-framework Feature and in diagnostics.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) Where(name string, predicate Predicate[T]) A {
+	return ra.withCheck(name, check[T]{quantifier: quantifierAll, predicate: predicate})
+}
+
+// ExactlyNWhere asserts that exactly N of the resources in the cluster that match the provided options satisfy the
+// predicate. Unlike ExactlyNMatch, any number of resources may match the provided options. The name is used to name the
+// step in the e2e-framework Feature and in diagnostics.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) ExactlyNWhere(name string, count int, predicate Predicate[T]) A {
+	return ra.withCheck(
+		name,
+		check[T]{quantifier: quantifierExactly, count: count, predicate: predicate, onlySatisfying: true},
+	)
+}
+
+// AtLeastNWhere asserts that at least N of the resources in the cluster that match the provided options satisfy the
+// predicate. Unlike AtLeastNMatch, any number of resources may match the provided options. The name is used to name the
+// step in the e2e-framework Feature and in diagnostics.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AtLeastNWhere(name string, count int, predicate Predicate[T]) A {
+	return ra.withCheck(
+		name,
+		check[T]{quantifier: quantifierAtLeast, count: count, predicate: predicate, onlySatisfying: true},
+	)
+}
+
+// Consistently makes the checks added after it hold for the whole window rather than eventually being satisfied. Each
+// check is evaluated at the assertion's interval (or on every change when watching) and fails the first time it is
+// not satisfied within the window. For example, the following asserts that a Deployment becomes available and then
+// stays available for five minutes:
+//
+//	NewDeploymentAssertion(opts...).IsAvailable().Consistently(5 * time.Minute).IsAvailable()
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) Consistently(window time.Duration) A {
+	res := ra.cloneResource()
+	res.consistently = window
+
+	return res.wrap(res)
+}
+
+// Eventually reverts the effect of Consistently so that the checks added after it only need to eventually be
+// satisfied within the assertion's timeout. This is the default.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) Eventually() A {
+	return ra.Consistently(0)
+}
+
+func (ra ResourceAssertion[T, A]) cloneResource() ResourceAssertion[T, A] {
+	return ResourceAssertion[T, A]{
+		Assertion:    Clone(ra.Assertion),
+		resource:     ra.resource,
+		kind:         ra.kind,
+		mapped:       ra.mapped,
+		wrap:         ra.wrap,
+		consistently: ra.consistently,
+		checks:       slices.Clone(ra.checks),
+		filters:      slices.Clone(ra.filters),
+	}
+}
+
+// withCheck returns a copy of the assertion with an additional step that waits for the check to be satisfied.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) withCheck(stepName string, chk check[T]) A {
+	chk.name = stepName
+	chk.consistently = ra.consistently
+
+	if chk.err == nil {
+		chk.err = ra.optionErr()
+	}
+
+	res := ra.cloneResource()
+	res.checks = append(res.checks, chk)
+	res.SetBuilder(res.GetBuilder().Assess(stepName, ra.stepFunc(chk)))
+
+	return res.wrap(res)
+}
+
+func (ra ResourceAssertion[T, A]) stepFunc(chk check[T]) features.Func {
+	return func(ctx context.Context, testingT *testing.T, cfg *envconf.Config) context.Context {
+		t := requireTIfNotNil(testingT, ra.GetRequireT())
+
+		diag, err := ra.runCheck(ctx, cfg, chk)
+
+		require.NoError(t, err, diag.String())
+
+		return ctx
+	}
+}
+
+// report records the outcome of a check in the assertion's report.Reporter, if any.
+func (ra ResourceAssertion[T, A]) report(cfg *envconf.Config, start time.Time, passed bool, diag Diagnostic) {
+	reporter := ra.GetReporter()
+	if reporter == nil {
+		return
+	}
+
+	listOpts := ra.ListOptions(cfg)
+
+	reporter.Record(report.Record{
+		Kind:          ra.KindName(),
+		Feature:       ra.GetBuilder().Feature().Name(),
+		LabelSelector: listOpts.LabelSelector,
+		FieldSelector: listOpts.FieldSelector,
+		Check:         diag.Check,
+		Start:         start,
+		Duration:      time.Since(start),
+		Passed:        passed,
+		Diagnostic:    diag.String(),
+	})
+}
+
+// waitForCheck waits for the check to be satisfied, or for it to be violated when it must hold consistently, recording
+// the observed state along the way.
+func (ra ResourceAssertion[T, A]) waitForCheck(
+	ctx context.Context,
+	cfg *envconf.Config,
+	chk check[T],
+	recorder *diagnosticRecorder,
+) error {
+	chk, err := chk.resolve(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	evaluate := func(ctx context.Context, items []T) bool {
+		ok, diag := chk.evaluate(ctx, items)
+		recorder.record(diag)
+
+		return ok
+	}
+
+	switch {
+	case chk.consistently > 0:
+		return ra.holdConsistently(ctx, cfg, chk.consistently, evaluate, recorder.recordErr)
+	case ra.GetWatch():
+		return ra.waitWithWatch(ctx, cfg, ra.GetTimeout(), evaluate, recorder.recordErr)
+	}
+
+	return WaitForCondition(ctx, ra, ra.conditionFunc(cfg, evaluate, recorder.recordErr))
+}
+
+// conditionFunc returns a ConditionWithContextFunc that lists the selected resources and evaluates them. Errors are
+// passed to observeErr so that they are reported if the check times out and only errors that are not retryable stop
+// the polling.
+func (ra ResourceAssertion[T, A]) conditionFunc(
+	cfg *envconf.Config,
+	evaluate func(context.Context, []T) bool,
+	observeErr func(error),
+) apimachinerywait.ConditionWithContextFunc {
+	return func(ctx context.Context) (bool, error) {
+		ctx, span := ra.tracer().Start(ctx, "kubeassert.attempt")
+		defer span.End()
+
+		items, err := ra.List(ctx, cfg)
+		if err != nil {
+			observeErr(err)
+
+			if IsRetryable(err) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		return evaluate(ctx, items), nil
+	}
+}
+
+// fromUnstructured converts unstructured objects to T.
+func fromUnstructured[T any](objs []unstructured.Unstructured) ([]T, error) {
+	items := make([]T, len(objs))
+
+	for i, obj := range objs {
+		// The converter only supports typed objects, so unstructured objects are used as is.
+		if item, ok := any(&items[i]).(*unstructured.Unstructured); ok {
+			*item = obj
+
+			continue
+		}
+
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &items[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+// Not returns a Predicate that is satisfied when the supplied predicate is not.
+func Not[T any](predicate Predicate[T]) Predicate[T] {
+	return func(obj T) bool {
+		return !predicate(obj)
+	}
+}
+
+//nolint:ireturn
+func requireTIfNotNil(testingT *testing.T, requireT require.TestingT) require.TestingT {
+	if requireT != nil {
+		return requireT
+	}
+
+	return testingT
+}
+
+// NewResourceAssertion creates a new ResourceAssertion for the supplied GroupVersionResource. The wrap function
+// converts the ResourceAssertion into the kind-specific assertion type A and the builder is used as the base
+// e2e-framework FeatureBuilder before any options are applied.
+func NewResourceAssertion[T any, A any](
+	resource schema.GroupVersionResource,
+	wrap func(ResourceAssertion[T, A]) A,
+	builder *features.FeatureBuilder,
+	opts ...Option,
+) ResourceAssertion[T, A] {
+	return ResourceAssertion[T, A]{
+		Assertion: NewAssertion(append([]Option{WithBuilder(builder)}, opts...)...),
+		resource:  resource,
+		wrap:      wrap,
+	}
+}
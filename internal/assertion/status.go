@@ -0,0 +1,269 @@
+package assertion
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Condition types that kstatus uses to determine whether a resource is current, in addition to the kind-specific
+// Available condition.
+const (
+	conditionReady       = "Ready"
+	conditionAvailable   = "Available"
+	conditionStalled     = "Stalled"
+	conditionReconciling = "Reconciling"
+	conditionTrue        = "True"
+
+	podSucceeded = "Succeeded"
+)
+
+// HasCondition asserts that exactly one resource that matches the provided options has a status condition of the type
+// with the status (e.g. HasCondition("Ready", "True")). Conditions are read from status.conditions, so this works for
+// any kind that follows the Kubernetes API conventions, including custom resources.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) HasCondition(conditionType, status string) A {
+	return ra.ExactlyNHaveCondition(1, conditionType, status)
+}
+
+// ExactlyNHaveCondition asserts that exactly N resources that match the provided options have a status condition of the
+// type with the status.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) ExactlyNHaveCondition(count int, conditionType, status string) A {
+	return ra.ExactlyNMatch("exactlyNHaveCondition", count, hasCondition[T](conditionType, status, nil))
+}
+
+// AtLeastNHaveCondition asserts that at least N resources that match the provided options have a status condition of
+// the type with the status.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AtLeastNHaveCondition(count int, conditionType, status string) A {
+	return ra.AtLeastNMatch("atLeastNHaveCondition", count, hasCondition[T](conditionType, status, nil))
+}
+
+// HasConditionWithReason asserts that exactly one resource that matches the provided options has a status condition of
+// the type with the status and reason (e.g. HasConditionWithReason("Ready", "False", "InstallFailed")).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) HasConditionWithReason(conditionType, status, reason string) A {
+	return ra.ExactlyNHaveConditionWithReason(1, conditionType, status, reason)
+}
+
+// ExactlyNHaveConditionWithReason asserts that exactly N resources that match the provided options have a status
+// condition of the type with the status and reason.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) ExactlyNHaveConditionWithReason(count int, conditionType, status, reason string) A {
+	return ra.ExactlyNMatch(
+		"exactlyNHaveConditionWithReason",
+		count,
+		hasCondition[T](conditionType, status, &reason),
+	)
+}
+
+// AtLeastNHaveConditionWithReason asserts that at least N resources that match the provided options have a status
+// condition of the type with the status and reason.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AtLeastNHaveConditionWithReason(count int, conditionType, status, reason string) A {
+	return ra.AtLeastNMatch(
+		"atLeastNHaveConditionWithReason",
+		count,
+		hasCondition[T](conditionType, status, &reason),
+	)
+}
+
+// IsCurrent asserts that exactly one resource that matches the provided options is current, meaning that its
+// controller has reconciled its latest spec. As with kstatus, a resource is current when:
+//
+//   - it is not being deleted;
+//   - status.observedGeneration, if set, matches metadata.generation;
+//   - its Ready and Available conditions, if set, are True;
+//   - its Stalled and Reconciling conditions, if set, are not True;
+//   - workloads (Deployments, StatefulSets, ReplicaSets and DaemonSets) have been observed by their controller, have
+//     updated, ready and available replicas for every desired one and no replicas left from a previous version;
+//   - StatefulSets have rolled out their update revision, unless they are updated with the OnDelete strategy.
+//
+// Pods that have succeeded are current even though they are no longer Ready and resources without a status (e.g.
+// ConfigMaps) are current once they exist.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) IsCurrent() A {
+	return ra.ExactlyNAreCurrent(1)
+}
+
+// ExactlyNAreCurrent asserts that exactly N resources that match the provided options are current (see IsCurrent).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) ExactlyNAreCurrent(count int) A {
+	return ra.ExactlyNMatch("exactlyNAreCurrent", count, isCurrent[T])
+}
+
+// AtLeastNAreCurrent asserts that at least N resources that match the provided options are current (see IsCurrent).
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AtLeastNAreCurrent(count int) A {
+	return ra.AtLeastNMatch("atLeastNAreCurrent", count, isCurrent[T])
+}
+
+// AllAreCurrent asserts that at least one resource exists in the cluster that matches the provided options and that
+// every one of them is current (see IsCurrent). This makes "everything has been reconciled" a single check.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) AllAreCurrent() A {
+	return ra.Where("allAreCurrent", isCurrent[T])
+}
+
+// hasCondition returns a Predicate that is satisfied by resources with a status condition of the type with the status
+// and, if it is not nil, the reason.
+func hasCondition[T any](conditionType, status string, reason *string) Predicate[T] {
+	return func(item T) bool {
+		content, err := toUnstructuredContent(&item)
+		if err != nil {
+			return false
+		}
+
+		for _, condition := range statusConditions(content) {
+			if condition["type"] != conditionType || condition["status"] != status {
+				continue
+			}
+
+			if reason == nil || condition["reason"] == *reason {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func isCurrent[T any](item T) bool {
+	content, err := toUnstructuredContent(&item)
+	if err != nil {
+		return false
+	}
+
+	obj := unstructured.Unstructured{Object: content}
+
+	if obj.GetDeletionTimestamp() != nil {
+		return false
+	}
+
+	observedGeneration, found, err := unstructured.NestedInt64(content, "status", "observedGeneration")
+	if err != nil || (found && observedGeneration != obj.GetGeneration()) {
+		return false
+	}
+
+	// Completed Pods are no longer Ready but will not change.
+	if phase, _, _ := unstructured.NestedString(content, "status", "phase"); phase == podSucceeded {
+		return true
+	}
+
+	for _, condition := range statusConditions(content) {
+		switch condition["type"] {
+		case conditionReady, conditionAvailable:
+			if condition["status"] != conditionTrue {
+				return false
+			}
+		case conditionStalled, conditionReconciling:
+			if condition["status"] == conditionTrue {
+				return false
+			}
+		}
+	}
+
+	kind := obj.GetKind()
+	if kind == "" {
+		// Typed objects listed from the API server do not have their kind set.
+		kind = reflect.TypeFor[T]().Name()
+	}
+
+	return hasDesiredReplicas(kind, content)
+}
+
+// hasDesiredReplicas returns true unless the resource is a workload that has not been observed by its controller, that
+// has fewer updated, ready or available replicas than desired, that still has replicas of a previous version or whose
+// updated replicas are not all available yet. The counts are omitted from the status of workloads when they are zero.
+func hasDesiredReplicas(kind string, content map[string]any) bool {
+	var desiredPath, totalPath, updatedPath, readyPath, availablePath []string
+
+	switch kind {
+	case "Deployment", "StatefulSet":
+		desiredPath = []string{"spec", "replicas"}
+		totalPath = []string{"status", "replicas"}
+		updatedPath = []string{"status", "updatedReplicas"}
+		readyPath = []string{"status", "readyReplicas"}
+		availablePath = []string{"status", "availableReplicas"}
+	case "ReplicaSet":
+		// ReplicaSets are not rolled out, so every replica is up to date.
+		desiredPath = []string{"spec", "replicas"}
+		totalPath = []string{"status", "replicas"}
+		readyPath = []string{"status", "readyReplicas"}
+		availablePath = []string{"status", "availableReplicas"}
+	case "DaemonSet":
+		desiredPath = []string{"status", "desiredNumberScheduled"}
+		totalPath = []string{"status", "currentNumberScheduled"}
+		updatedPath = []string{"status", "updatedNumberScheduled"}
+		readyPath = []string{"status", "numberReady"}
+		availablePath = []string{"status", "numberAvailable"}
+	default:
+		return true
+	}
+
+	if _, observed, _ := unstructured.NestedInt64(content, "status", "observedGeneration"); !observed {
+		return false
+	}
+
+	desired, found, _ := unstructured.NestedInt64(content, desiredPath...)
+	if !found && desiredPath[0] == "spec" {
+		// The number of replicas defaults to one.
+		desired = 1
+	}
+
+	updated := desired
+	if updatedPath != nil {
+		updated, _, _ = unstructured.NestedInt64(content, updatedPath...)
+	}
+
+	total, _, _ := unstructured.NestedInt64(content, totalPath...)
+	ready, _, _ := unstructured.NestedInt64(content, readyPath...)
+	available, _, _ := unstructured.NestedInt64(content, availablePath...)
+
+	// Replicas beyond the desired ones belong to a previous version that is still being scaled down.
+	if total > desired || updated < desired || ready < desired || available < updated {
+		return false
+	}
+
+	return kind != "StatefulSet" || hasUpdatedRevision(content)
+}
+
+// hasUpdatedRevision returns true if the StatefulSet has finished rolling out its update revision. As with kstatus,
+// StatefulSets that are updated with the OnDelete strategy are not compared, as their controller does not update the
+// current revision once every Pod has been replaced.
+func hasUpdatedRevision(content map[string]any) bool {
+	if strategy, _, _ := unstructured.NestedString(content, "spec", "updateStrategy", "type"); strategy == "OnDelete" {
+		return true
+	}
+
+	currentRevision, _, _ := unstructured.NestedString(content, "status", "currentRevision")
+	updateRevision, _, _ := unstructured.NestedString(content, "status", "updateRevision")
+
+	return currentRevision == updateRevision
+}
+
+// statusConditions returns the conditions in status.conditions. Conditions that are not objects are ignored.
+func statusConditions(content map[string]any) []map[string]any {
+	listed, _, _ := unstructured.NestedSlice(content, "status", "conditions")
+
+	conditions := make([]map[string]any, 0, len(listed))
+
+	for _, item := range listed {
+		if condition, ok := item.(map[string]any); ok {
+			conditions = append(conditions, condition)
+		}
+	}
+
+	return conditions
+}
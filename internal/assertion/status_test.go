@@ -0,0 +1,174 @@
+package assertion_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+	"github.com/DWSR/kubeassert-go/internal/resources"
+)
+
+var widgetKind = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+
+func withState(state string) assertion.Option {
+	return assertion.WithResourceLabels(map[string]string{"state": state})
+}
+
+func TestIsCurrent(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/status.yaml")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		assert assertion.ObjectEvaluator
+		passed bool
+	}{
+		{
+			name:   "Current",
+			assert: newDeploymentAssertion(withState("current")).IsCurrent(),
+			passed: true,
+		},
+		{
+			name:   "ObservedGenerationBehind",
+			assert: newDeploymentAssertion(withState("stale")).IsCurrent(),
+			passed: false,
+		},
+		{
+			name:   "RollingOut",
+			assert: newDeploymentAssertion(withState("rolling")).IsCurrent(),
+			passed: false,
+		},
+		{
+			name:   "NotObserved",
+			assert: newDeploymentAssertion(withState("unobserved")).IsCurrent(),
+			passed: false,
+		},
+		{
+			name:   "AtLeastNAreCurrent",
+			assert: newDeploymentAssertion().AtLeastNAreCurrent(1),
+			passed: true,
+		},
+		{
+			name:   "AllAreCurrent",
+			assert: newDeploymentAssertion().AllAreCurrent(),
+			passed: false,
+		},
+		{
+			name:   "CustomResource_Ready",
+			assert: resources.NewResourceAssertion(widgetKind, assertion.WithResourceName("ready")).IsCurrent(),
+			passed: true,
+		},
+		{
+			name:   "CustomResource_Stalled",
+			assert: resources.NewResourceAssertion(widgetKind, assertion.WithResourceName("stalled")).IsCurrent(),
+			passed: false,
+		},
+		{
+			name: "WithoutStatus",
+			assert: resources.NewResourceAssertion(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}).
+				AllAreCurrent(),
+			passed: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.assert.EvaluateObjects(objs)
+			require.NoError(t, err)
+			require.Len(t, res.Checks, 1)
+			require.Equal(t, testCase.passed, res.Checks[0].Passed, res.String())
+		})
+	}
+}
+
+func TestIsCurrent_Rollouts(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/rollouts.yaml")
+	require.NoError(t, err)
+
+	isCurrent := func(kind, name string) assertion.ObjectEvaluator {
+		gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: kind}
+
+		return resources.NewResourceAssertion(gvk, assertion.WithResourceName(name)).IsCurrent()
+	}
+
+	testCases := []struct {
+		name   string
+		assert assertion.ObjectEvaluator
+		passed bool
+	}{
+		{name: "Deployment_RolledOut", assert: isCurrent("Deployment", "rolled-out"), passed: true},
+		{name: "Deployment_OldReplicas", assert: isCurrent("Deployment", "terminating-old-replicas"), passed: false},
+		{name: "Deployment_UpdatedNotAvailable", assert: isCurrent("Deployment", "updated-not-available"), passed: false},
+		{name: "StatefulSet_RolledOut", assert: isCurrent("StatefulSet", "rolled-out"), passed: true},
+		{name: "StatefulSet_RevisionBehind", assert: isCurrent("StatefulSet", "revision-behind"), passed: false},
+		{name: "StatefulSet_OnDelete", assert: isCurrent("StatefulSet", "on-delete"), passed: true},
+		{name: "DaemonSet_UpdatedNotAvailable", assert: isCurrent("DaemonSet", "updated-not-available"), passed: false},
+		{name: "ReplicaSet_ScalingDown", assert: isCurrent("ReplicaSet", "scaling-down"), passed: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.assert.EvaluateObjects(objs)
+			require.NoError(t, err)
+			require.Len(t, res.Checks, 1)
+			require.Equal(t, testCase.passed, res.Checks[0].Passed, res.String())
+		})
+	}
+}
+
+func TestHasCondition(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/status.yaml")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		assert assertion.ObjectEvaluator
+		passed bool
+	}{
+		{
+			name:   "HasCondition",
+			assert: newDeploymentAssertion(withState("current")).HasCondition("Progressing", "True"),
+			passed: true,
+		},
+		{
+			name:   "HasCondition_Missing",
+			assert: newDeploymentAssertion(withState("stale")).HasCondition("Progressing", "True"),
+			passed: false,
+		},
+		{
+			name:   "AtLeastNHaveCondition",
+			assert: newDeploymentAssertion().AtLeastNHaveCondition(3, "Available", "True"),
+			passed: true,
+		},
+		{
+			name: "ExactlyNHaveCondition",
+			assert: resources.NewResourceAssertion(widgetKind, assertion.WithResourceNamespace("status")).
+				ExactlyNHaveCondition(2, "Ready", "True"),
+			passed: false,
+		},
+		{
+			name: "HasConditionWithReason",
+			assert: resources.NewResourceAssertion(widgetKind, assertion.WithResourceName("stalled")).
+				HasConditionWithReason("Ready", "False", "InstallFailed"),
+			passed: true,
+		},
+		{
+			name: "HasConditionWithReason_OtherReason",
+			assert: resources.NewResourceAssertion(widgetKind, assertion.WithResourceName("ready")).
+				HasConditionWithReason("Ready", "True", "InstallFailed"),
+			passed: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.assert.EvaluateObjects(objs)
+			require.NoError(t, err)
+			require.Len(t, res.Checks, 1)
+			require.Equal(t, testCase.passed, res.Checks[0].Passed, res.String())
+		})
+	}
+}
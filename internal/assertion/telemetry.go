@@ -0,0 +1,120 @@
+package assertion
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/metrics"
+)
+
+const instrumentationName = "github.com/DWSR/kubeassert-go"
+
+// tracer returns the tracer used to record spans about the assertion. The global TracerProvider is used unless the
+// assertion has its own.
+//
+//nolint:ireturn
+func (ra ResourceAssertion[T, A]) tracer() trace.Tracer {
+	provider := ra.GetTracerProvider()
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	return provider.Tracer(instrumentationName)
+}
+
+// runCheck waits for the check to be satisfied and returns the last observed state of the resources. A span is
+// recorded for the check and its outcome is added to the metrics and the assertion's report.Reporter, if any.
+func (ra ResourceAssertion[T, A]) runCheck(ctx context.Context, cfg *envconf.Config, chk check[T]) (Diagnostic, error) {
+	kind := ra.KindName()
+	listOpts := ra.ListOptions(cfg)
+
+	ctx, span := ra.tracer().Start(
+		ctx,
+		"kubeassert.check "+chk.name,
+		trace.WithAttributes(
+			attribute.String("kubeassert.kind", kind),
+			attribute.String("kubeassert.feature", ra.GetBuilder().Feature().Name()),
+			attribute.String("kubeassert.check", chk.name),
+			attribute.String("kubeassert.check_detail", chk.detail),
+			attribute.String("kubeassert.expected", chk.quantifier.describe(chk.count)),
+			attribute.String("kubeassert.label_selector", listOpts.LabelSelector),
+			attribute.String("kubeassert.field_selector", listOpts.FieldSelector),
+		),
+	)
+	defer span.End()
+
+	recorder := newDiagnosticRecorder(chk)
+	start := time.Now()
+
+	err := ra.waitForCheck(ctx, cfg, chk, recorder)
+	diag := recorder.last()
+	result := metrics.ResultPassed
+
+	span.SetAttributes(
+		attribute.Int("kubeassert.attempts", recorder.attempts()),
+		attribute.Int("kubeassert.selected", diag.Selected),
+		attribute.Int("kubeassert.satisfied", diag.Satisfied),
+	)
+
+	if err != nil {
+		result = metrics.ResultFailed
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, diag.String())
+		metrics.CheckFailures.WithLabelValues(kind, chk.name).Inc()
+	}
+
+	metrics.CheckDuration.WithLabelValues(kind, chk.name, result).Observe(time.Since(start).Seconds())
+	metrics.CheckAttempts.WithLabelValues(kind, chk.name).Observe(float64(recorder.attempts()))
+
+	ra.report(cfg, start, err == nil, diag)
+
+	return diag, err
+}
+
+// listResources lists resources from the API server, recording a span and the latency of the request.
+func (ra ResourceAssertion[T, A]) listResources(
+	ctx context.Context,
+	client dynamic.ResourceInterface,
+	resource schema.GroupVersionResource,
+	opts metav1.ListOptions,
+) (*unstructured.UnstructuredList, error) {
+	ctx, span := ra.tracer().Start(
+		ctx,
+		"kubeassert.list",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("kubeassert.resource", resource.String()),
+			attribute.String("kubeassert.label_selector", opts.LabelSelector),
+			attribute.String("kubeassert.field_selector", opts.FieldSelector),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	result := metrics.ResultSuccess
+
+	list, err := client.List(ctx, opts)
+	if err != nil {
+		result = metrics.ResultError
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetAttributes(attribute.Int("kubeassert.items", len(list.Items)))
+	}
+
+	metrics.ListDuration.WithLabelValues(resource.Resource, result).Observe(time.Since(start).Seconds())
+
+	return list, err
+}
@@ -0,0 +1,51 @@
+package assertion_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/e2e-framework/pkg/env"
+	"sigs.k8s.io/e2e-framework/pkg/features"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/metrics"
+	"github.com/DWSR/kubeassert-go/internal/testhelpers"
+)
+
+func TestRunCheck_KindLabel(t *testing.T) {
+	testEnv := env.NewWithConfig(newUnavailableConfig(t))
+
+	testhelpers.TestFailingAsserts(t, testEnv, testhelpers.FailingAssert{
+		Name: "CustomFeature",
+		FailingAssert: func(t require.TestingT) assertion.Assertion {
+			return newDeploymentAssertion(
+				assertion.WithRequireT(t),
+				assertion.WithBuilder(features.New("deployment 7f3c is gone")),
+				assertion.WithInterval(10*time.Millisecond),
+			).Consistently(50 * time.Millisecond).NoneExist()
+		},
+	})
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(metrics.CheckFailures)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	kinds := map[string]bool{}
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "kind" {
+					kinds[label.GetValue()] = true
+				}
+			}
+		}
+	}
+
+	require.True(t, kinds["Deployment"])
+	require.False(t, kinds["deployment 7f3c is gone"])
+}
@@ -0,0 +1,122 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+)
+
+// waitWithWatch waits for evaluate to return true. Rather than polling, the selected resources are kept in an
+// informer-backed store and evaluate is called once the store has synced and again each time a resource is added,
+// modified or deleted until the timeout elapses, or until ctx is done when the timeout is not positive. Errors from the
+// API server are passed to observeErr; retryable errors are retried by the informer whereas any other error stops the
+// wait.
+func (ra ResourceAssertion[T, A]) waitWithWatch(
+	ctx context.Context,
+	cfg *envconf.Config,
+	timeout time.Duration,
+	evaluate func(context.Context, []T) bool,
+	observeErr func(error),
+) error {
+	listOpts := ra.ListOptions(cfg)
+
+	resourceClient, resource, err := ra.resourceClient(cfg, listOpts)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := context.WithCancelCause(ctx)
+	defer stop(nil)
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	handleErr := func(err error) {
+		if err == nil {
+			return
+		}
+
+		observeErr(err)
+
+		if !IsRetryable(err) {
+			stop(err)
+		}
+	}
+
+	listWatch := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = listOpts.LabelSelector
+			options.FieldSelector = listOpts.FieldSelector
+
+			list, err := ra.listResources(ctx, resourceClient, resource, options)
+			handleErr(err)
+
+			return list, err
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = listOpts.LabelSelector
+			options.FieldSelector = listOpts.FieldSelector
+
+			watcher, err := resourceClient.Watch(ctx, options)
+			handleErr(err)
+
+			return watcher, err
+		},
+	}
+
+	var store cache.Store
+
+	evaluateStore := func() (bool, error) {
+		objs := make([]unstructured.Unstructured, 0, len(store.List()))
+
+		for _, obj := range store.List() {
+			if u, ok := obj.(*unstructured.Unstructured); ok {
+				objs = append(objs, *u)
+			}
+		}
+
+		objs, err := ra.filterObjects(ctx, cfg, objs)
+		if err != nil {
+			return false, err
+		}
+
+		items, err := fromUnstructured[T](objs)
+		if err != nil {
+			return false, err
+		}
+
+		return evaluate(ctx, items), nil
+	}
+
+	_, err = watchtools.UntilWithSync(
+		ctx,
+		listWatch,
+		&unstructured.Unstructured{},
+		func(syncedStore cache.Store) (bool, error) {
+			store = syncedStore
+
+			return evaluateStore()
+		},
+		func(_ watch.Event) (bool, error) {
+			return evaluateStore()
+		},
+	)
+	if cause := context.Cause(ctx); cause != nil && !errors.Is(cause, context.DeadlineExceeded) &&
+		!errors.Is(cause, context.Canceled) {
+		return cause
+	}
+
+	return err
+}
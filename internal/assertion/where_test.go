@@ -0,0 +1,76 @@
+package assertion_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+)
+
+func hasLabel(deploy appsv1.Deployment) bool {
+	return deploy.Labels["app"] != ""
+}
+
+func TestWhere(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/deployments.yaml")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		assert deploymentAssertion
+		passed bool
+	}{
+		{
+			name:   "Where",
+			assert: newDeploymentAssertion().Where("hasLabel", hasLabel),
+			passed: true,
+		},
+		{
+			name:   "Where_NotAll",
+			assert: newDeploymentAssertion().Where("critical", isSystemClusterCritical),
+			passed: false,
+		},
+		{
+			name:   "Where_NoneSelected",
+			assert: newDeploymentAssertion(assertion.WithResourceNamespace("missing")).Where("hasLabel", hasLabel),
+			passed: false,
+		},
+		{
+			name:   "ExactlyNWhere",
+			assert: newDeploymentAssertion().ExactlyNWhere("critical", 1, isSystemClusterCritical),
+			passed: true,
+		},
+		{
+			name:   "ExactlyNWhere_TooMany",
+			assert: newDeploymentAssertion().ExactlyNWhere("hasLabel", 1, hasLabel),
+			passed: false,
+		},
+		{
+			name:   "ExactlyNMatch_CountsSelected",
+			assert: newDeploymentAssertion().ExactlyNMatch("critical", 1, isSystemClusterCritical),
+			passed: false,
+		},
+		{
+			name:   "AtLeastNWhere",
+			assert: newDeploymentAssertion().AtLeastNWhere("hasLabel", 2, hasLabel),
+			passed: true,
+		},
+		{
+			name:   "AtLeastNWhere_TooFew",
+			assert: newDeploymentAssertion().AtLeastNWhere("critical", 2, isSystemClusterCritical),
+			passed: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.assert.EvaluateObjects(objs)
+			require.NoError(t, err)
+			require.Len(t, res.Checks, 1)
+			require.Equal(t, testCase.passed, res.Passed(), res.String())
+		})
+	}
+}
@@ -2,7 +2,6 @@
 package assertionhelpers
 
 import (
-	"bytes"
 	"context"
 	"log/slog"
 	"os"
@@ -13,24 +12,20 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	apimachinerywait "k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/apimachinery/pkg/util/yaml"
-	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/restmapper"
 	"sigs.k8s.io/e2e-framework/klient/decoder"
+	"sigs.k8s.io/e2e-framework/klient/k8s"
 	"sigs.k8s.io/e2e-framework/klient/k8s/resources"
-	"sigs.k8s.io/e2e-framework/klient/wait"
 	"sigs.k8s.io/e2e-framework/pkg/env"
 	"sigs.k8s.io/e2e-framework/pkg/envconf"
 	"sigs.k8s.io/e2e-framework/pkg/features"
 	e2etypes "sigs.k8s.io/e2e-framework/pkg/types"
-	"sigs.k8s.io/kustomize/api/krusty"
-	kusttypes "sigs.k8s.io/kustomize/api/types"
-	"sigs.k8s.io/kustomize/kyaml/filesys"
 
 	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/clients"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
 )
 
 type (
@@ -49,6 +44,10 @@ type (
 	StepFunc = features.Func
 )
 
+// crdGroupKind is the GroupKind of CustomResourceDefinitions. Applying one changes the resources served by the API
+// server.
+var crdGroupKind = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+
 var (
 	// IntCompareFuncLessThan is a function that compares two integers and returns true if the first integer is less
 	// than the second.
@@ -115,7 +114,20 @@ func CreateResourceFromPath(resourcePath string, decoderOpts ...decoder.DecodeOp
 
 		defer func() { _ = file.Close() }()
 
-		err = decoder.DecodeEach(ctx, file, decoder.CreateHandler(res), decoderOpts...)
+		create := decoder.CreateHandler(res)
+
+		err = decoder.DecodeEach(ctx, file, func(ctx context.Context, obj k8s.Object) error {
+			if err := create(ctx, obj); err != nil {
+				return err
+			}
+
+			// Account for new CRDs so that assertions on their custom resources can be mapped.
+			if obj.GetObjectKind().GroupVersionKind().GroupKind() == crdGroupKind {
+				clients.Reset(cfg)
+			}
+
+			return nil
+		}, decoderOpts...)
 		require.NoError(t, err)
 
 		return ctx
@@ -140,6 +152,20 @@ func DeleteResourceFromPath(resourcePath string, decoderOpts ...decoder.DecodeOp
 	}
 }
 
+// DeleteResourceFromPathWithNamespaceFromEnv deletes a resource from a file at the provided path and sets the
+// resource's namespace to the one provided in the environment configuration.
+func DeleteResourceFromPathWithNamespaceFromEnv(
+	resourcePath string,
+	decoderOpts ...decoder.DecodeOption,
+) e2etypes.StepFunc {
+	return func(ctx context.Context, t *testing.T, cfg *envconf.Config) context.Context {
+		return DeleteResourceFromPath(
+			resourcePath,
+			append(decoderOpts, decoder.MutateNamespace(cfg.Namespace()))...,
+		)(ctx, t, cfg)
+	}
+}
+
 // Sleep returns a StepFunc that sleeps for the provided duration.
 func Sleep(sleepTime time.Duration) e2etypes.StepFunc {
 	return func(ctx context.Context, _ *testing.T, _ *envconf.Config) context.Context {
@@ -149,15 +175,14 @@ func Sleep(sleepTime time.Duration) e2etypes.StepFunc {
 	}
 }
 
-// DynamicClientFromEnvconf creates a dynamic client from the environment configuration.
-func DynamicClientFromEnvconf(t require.TestingT, cfg *envconf.Config) *dynamic.DynamicClient {
-	klient, err := cfg.NewClient()
-	require.NoError(t, err)
-
-	client, err := dynamic.NewForConfig(klient.RESTConfig())
+// DynamicClientFromEnvconf returns the shared dynamic client for the environment configuration.
+//
+//nolint:ireturn
+func DynamicClientFromEnvconf(t require.TestingT, cfg *envconf.Config) dynamic.Interface {
+	shared, err := clients.ForConfig(cfg)
 	require.NoError(t, err)
 
-	return client
+	return shared.Dynamic
 }
 
 // RequireTIfNotNil returns the require.TestingT object if it is not nil, otherwise it returns the provided testing.T
@@ -184,78 +209,28 @@ func TestAssertions(t *testing.T, testEnv env.Environment, assertions ...asserti
 }
 
 // ApplyKustomization applies a kustomization at the provided directory.
-//
-// TODO: refactor this function to be more testable and also simpler
-//
-//nolint:funlen,cyclop
 func ApplyKustomization(kustDir string) env.Func {
 	return func(ctx context.Context, cfg *envconf.Config) (context.Context, error) {
-		diskFS := filesys.MakeFsOnDisk()
-		opts := krusty.MakeDefaultOptions()
-		opts.PluginConfig.HelmConfig = kusttypes.HelmConfig{
-			Enabled: true,
-			Command: "helm",
-			Debug:   false,
-		}
-		opts.PluginConfig.FnpLoadingOptions.Network = true
-		opts.LoadRestrictions = kusttypes.LoadRestrictionsNone
-		opts.Reorder = krusty.ReorderOptionLegacy
-		kust := krusty.MakeKustomizer(opts)
-
 		slog.Debug("rendering kustomization")
 
-		resMap, err := kust.Run(diskFS, kustDir)
+		objs, err := manifests.RenderKustomization(kustDir)
 		if err != nil {
 			return ctx, err
 		}
 
 		slog.Debug("creating client")
 
-		klient, err := cfg.NewClient()
-		if err != nil {
-			return ctx, err
-		}
-
-		client, err := dynamic.NewForConfig(klient.RESTConfig())
+		shared, err := clients.ForConfig(cfg)
 		if err != nil {
 			return ctx, err
 		}
 
 		slog.Debug("applying kustomization")
 
-		for _, res := range resMap.Resources() {
-			// Do this inside the loop to account for new CRDs, etc. that get applied
-			slog.Debug("creating resource mapper")
-
-			discoveryClient, err := discovery.NewDiscoveryClientForConfig(klient.RESTConfig())
-			if err != nil {
-				return ctx, err
-			}
-
-			gr, err := restmapper.GetAPIGroupResources(discoveryClient)
-			if err != nil {
-				return ctx, err
-			}
-
-			restMapper := restmapper.NewDiscoveryRESTMapper(gr)
-
-			slog.Debug("transmuting resMap resource to unstructured")
-
-			yamlBytes, err := res.AsYAML()
-			if err != nil {
-				return ctx, err
-			}
-
-			obj := &unstructured.Unstructured{}
-
-			decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(yamlBytes), len(yamlBytes))
-			if err := decoder.Decode(obj); err != nil {
-				return ctx, err
-			}
-
+		for _, obj := range objs {
 			gvk := obj.GroupVersionKind()
 
-			mapping, err := restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+			mapping, err := shared.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
 			if err != nil {
 				return ctx, err
 			}
@@ -266,18 +241,23 @@ func ApplyKustomization(kustDir string) env.Func {
 
 			switch mapping.Scope.Name() {
 			case meta.RESTScopeNameNamespace:
-				resourceClient = client.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+				resourceClient = shared.Dynamic.Resource(mapping.Resource).Namespace(obj.GetNamespace())
 			case meta.RESTScopeNameRoot:
-				resourceClient = client.Resource(mapping.Resource)
+				resourceClient = shared.Dynamic.Resource(mapping.Resource)
 			}
 
-			_, err = resourceClient.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+			_, err = resourceClient.Apply(ctx, obj.GetName(), &obj, metav1.ApplyOptions{
 				Force:        true,
 				FieldManager: "e2e-test",
 			})
 			if err != nil {
 				return ctx, err
 			}
+
+			// Account for new CRDs, etc. so that subsequent resources can be mapped.
+			if gvk.GroupKind() == crdGroupKind {
+				shared.Mapper.Reset()
+			}
 		}
 
 		return ctx, nil
@@ -291,13 +271,7 @@ func WaitForCondition(
 	assert assertion.Assertion,
 	conditionFunc apimachinerywait.ConditionWithContextFunc,
 ) error {
-	return wait.For(
-		conditionFunc,
-		wait.WithContext(ctx),
-		wait.WithTimeout(assert.GetTimeout()),
-		wait.WithInterval(assert.GetInterval()),
-		wait.WithImmediate(),
-	)
+	return assertion.WaitForCondition(ctx, assert, conditionFunc)
 }
 
 // AsStepFunc returns a StepFunc that waits for a condition to be satisfied based on the provided ConditionFuncFactory.
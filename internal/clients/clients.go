@@ -0,0 +1,207 @@
+// clients provides Kubernetes clients that are shared by every assertion and helper using the same
+// envconf.Config. Building clients and discovering the API server's resources is comparatively expensive, so doing it
+// once per cluster rather than on every poll significantly reduces load on the API server in large suites.
+package clients
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/e2e-framework/klient"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+)
+
+type (
+	// Clients is a set of clients built from a single envconf.Config.
+	Clients struct {
+		// RESTConfig is the configuration used to build the clients. It is nil for registered Clients that are not
+		// backed by an API server (e.g. a fake cluster).
+		RESTConfig *rest.Config
+		// Dynamic is a dynamic client for the cluster.
+		Dynamic dynamic.Interface
+		// Mapper maps GroupVersionKinds to GroupVersionResources using cached discovery information. It must be reset
+		// (see Reset) when the set of resources served by the API server changes (e.g. a CRD is applied).
+		Mapper meta.ResettableRESTMapper
+		// Logs reads the logs of containers.
+		Logs PodLogReader
+		// Exec runs commands in containers.
+		Exec PodExecutor
+		// Proxy sends HTTP requests to Pods and Services.
+		Proxy ProxyGetter
+	}
+
+	// cacheKey identifies the cluster, and the identity used to access it, that clients are built for. Clients are
+	// cached by cluster, rather than by envconf.Config, as an env.Environment runs each Feature with a copy of its
+	// envconf.Config.
+	cacheKey struct {
+		kubeconfig string
+		context    string
+		// restConfig is the REST configuration of the envconf.Config's client, if it has one (e.g. see ConfigFor).
+		// Clients are cached by *rest.Config, rather than by API server, so that configurations for the same API server
+		// with different credentials (e.g. a bearer token or impersonation) never share Clients.
+		restConfig *rest.Config
+	}
+)
+
+var (
+	cacheMu    sync.Mutex
+	cache      = make(map[cacheKey]*Clients)
+	configs    = make(map[*rest.Config]*envconf.Config)
+	registered = make(map[string]*Clients)
+)
+
+// ForConfig returns the Clients for the supplied envconf.Config, building and caching them on first use.
+func ForConfig(cfg *envconf.Config) (*Clients, error) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	if clients, ok := registered[cfg.KubeconfigFile()]; ok {
+		return clients, nil
+	}
+
+	key := keyFor(cfg)
+
+	if clients, ok := cache[key]; ok {
+		return clients, nil
+	}
+
+	client, err := cfg.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := New(client.RESTConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	cache[key] = clients
+
+	return clients, nil
+}
+
+// Register sets the Clients used for every envconf.Config with the same kubeconfig file as the supplied one instead of
+// building them from the kubeconfig. It enables assertions to be evaluated against a different client source, such as
+// an in-memory fake cluster. Clients are registered by kubeconfig file, rather than by envconf.Config, as an
+// env.Environment runs each Feature with a copy of its envconf.Config.
+func Register(cfg *envconf.Config, clients *Clients) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	registered[cfg.KubeconfigFile()] = clients
+}
+
+// ConfigFor returns an envconf.Config that uses the supplied REST configuration. It enables assertions to be evaluated
+// outside of an e2e-framework test (e.g. in an operator). The same envconf.Config, and therefore the same Clients, is
+// returned each time it is called with the same *rest.Config until it is released with Release.
+func ConfigFor(restConfig *rest.Config) (*envconf.Config, error) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	if cfg, ok := configs[restConfig]; ok {
+		return cfg, nil
+	}
+
+	client, err := klient.New(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := envconf.New().WithClient(client)
+	configs[restConfig] = cfg
+
+	return cfg, nil
+}
+
+// Release removes the envconf.Config returned by ConfigFor for the supplied REST configuration, and its Clients, from
+// the cache. They are cached for each *rest.Config until it is released, so long-running processes that evaluate
+// assertions with a new *rest.Config each time (e.g. an operator or a health check job) must release each one once
+// it is no longer used. Releasing a *rest.Config that is not cached does nothing.
+func Release(restConfig *rest.Config) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	cfg, ok := configs[restConfig]
+	if !ok {
+		return
+	}
+
+	delete(cache, keyFor(cfg))
+	delete(configs, restConfig)
+}
+
+// Reset discards the cached discovery information of the Clients for the supplied envconf.Config, if any, so that
+// resources added to the API server (e.g. by applying a CRD) can be mapped.
+func Reset(cfg *envconf.Config) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	if clients, ok := registered[cfg.KubeconfigFile()]; ok && clients.Mapper != nil {
+		clients.Mapper.Reset()
+	}
+
+	if clients, ok := cache[keyFor(cfg)]; ok {
+		clients.Mapper.Reset()
+	}
+}
+
+// Forget removes the Clients for the cluster of the supplied envconf.Config from the cache, along with any Clients
+// registered for its kubeconfig file.
+func Forget(cfg *envconf.Config) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	delete(cache, keyFor(cfg))
+	delete(registered, cfg.KubeconfigFile())
+}
+
+// keyFor returns the key of the cluster of the envconf.Config in the cache.
+func keyFor(cfg *envconf.Config) cacheKey {
+	key := cacheKey{kubeconfig: cfg.KubeconfigFile(), context: cfg.KubeContext(), restConfig: nil}
+
+	if client := cfg.GetClient(); client != nil {
+		key.restConfig = client.RESTConfig()
+	}
+
+	return key
+}
+
+// New builds a new, uncached set of Clients from the supplied REST configuration.
+func New(restConfig *rest.Config) (*Clients, error) {
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := kubernetes.NewForConfigAndClient(restConfig, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := podClient{restConfig: restConfig, clientset: clientset}
+
+	return &Clients{
+		RESTConfig: restConfig,
+		Dynamic:    dynamicClient,
+		Mapper:     restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+		Logs:       pods,
+		Exec:       pods,
+		Proxy:      proxyClient{clientset: clientset, httpClient: httpClient},
+	}, nil
+}
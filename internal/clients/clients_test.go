@@ -0,0 +1,189 @@
+package clients_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/clients"
+)
+
+const kubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+  - name: test
+    cluster:
+      server: https://127.0.0.1:6443
+contexts:
+  - name: test
+    context:
+      cluster: test
+      user: test
+current-context: test
+users:
+  - name: test
+    user:
+      token: test
+`
+
+func writeKubeconfig(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	require.NoError(t, os.WriteFile(path, []byte(kubeconfig), 0o600))
+
+	return path
+}
+
+func TestForConfig_Cached(t *testing.T) {
+	cfg := envconf.New().WithKubeconfigFile(writeKubeconfig(t))
+
+	first, err := clients.ForConfig(cfg)
+	require.NoError(t, err)
+
+	second, err := clients.ForConfig(cfg)
+	require.NoError(t, err)
+
+	require.Same(t, first, second)
+}
+
+func TestForConfig_SharedByCopies(t *testing.T) {
+	path := writeKubeconfig(t)
+	cfg := envconf.New().WithKubeconfigFile(path)
+
+	first, err := clients.ForConfig(cfg)
+	require.NoError(t, err)
+
+	// An env.Environment evaluates each Feature with a copy of the envconf.Config.
+	configCopy := *cfg
+
+	second, err := clients.ForConfig(&configCopy)
+	require.NoError(t, err)
+	require.Same(t, first, second)
+
+	third, err := clients.ForConfig(envconf.New().WithKubeconfigFile(path))
+	require.NoError(t, err)
+	require.Same(t, first, third)
+
+	other, err := clients.ForConfig(envconf.New().WithKubeconfigFile(path).WithKubeContext("other"))
+	require.NoError(t, err)
+	require.NotSame(t, first, other)
+}
+
+func TestForConfig_RebuiltWhenKubeconfigChanges(t *testing.T) {
+	cfg := envconf.New().WithKubeconfigFile(writeKubeconfig(t))
+
+	first, err := clients.ForConfig(cfg)
+	require.NoError(t, err)
+
+	cfg.WithKubeconfigFile(writeKubeconfig(t))
+
+	second, err := clients.ForConfig(cfg)
+	require.NoError(t, err)
+
+	require.NotSame(t, first, second)
+}
+
+func TestForget(t *testing.T) {
+	cfg := envconf.New().WithKubeconfigFile(writeKubeconfig(t))
+
+	first, err := clients.ForConfig(cfg)
+	require.NoError(t, err)
+
+	clients.Forget(cfg)
+
+	second, err := clients.ForConfig(cfg)
+	require.NoError(t, err)
+
+	require.NotSame(t, first, second)
+}
+
+func TestConfigFor_Cached(t *testing.T) {
+	restConfig := &rest.Config{Host: "https://127.0.0.1:6443"}
+
+	first, err := clients.ConfigFor(restConfig)
+	require.NoError(t, err)
+
+	second, err := clients.ConfigFor(restConfig)
+	require.NoError(t, err)
+
+	require.Same(t, first, second)
+
+	other, err := clients.ConfigFor(&rest.Config{Host: "https://127.0.0.1:6443"})
+	require.NoError(t, err)
+
+	require.NotSame(t, first, other)
+}
+
+func TestRelease(t *testing.T) {
+	restConfig := &rest.Config{Host: "https://127.0.0.1:6443"}
+
+	cfg, err := clients.ConfigFor(restConfig)
+	require.NoError(t, err)
+
+	first, err := clients.ForConfig(cfg)
+	require.NoError(t, err)
+
+	clients.Release(restConfig)
+	clients.Release(restConfig)
+
+	released, err := clients.ConfigFor(restConfig)
+	require.NoError(t, err)
+	require.NotSame(t, cfg, released)
+
+	second, err := clients.ForConfig(released)
+	require.NoError(t, err)
+	require.NotSame(t, first, second)
+}
+
+func TestRegister(t *testing.T) {
+	cfg := envconf.NewWithKubeConfig("registered")
+	registered := &clients.Clients{}
+
+	clients.Register(cfg, registered)
+	t.Cleanup(func() { clients.Forget(cfg) })
+
+	shared, err := clients.ForConfig(cfg)
+	require.NoError(t, err)
+	require.Same(t, registered, shared)
+
+	// An env.Environment evaluates Features with a copy of the envconf.Config.
+	configCopy := *cfg
+
+	shared, err = clients.ForConfig(&configCopy)
+	require.NoError(t, err)
+	require.Same(t, registered, shared)
+}
+
+func TestForConfig_ByCredentials(t *testing.T) {
+	admin, err := clients.ConfigFor(&rest.Config{Host: "https://127.0.0.1:6443", BearerToken: "admin"})
+	require.NoError(t, err)
+
+	viewer, err := clients.ConfigFor(&rest.Config{
+		Host:        "https://127.0.0.1:6443",
+		BearerToken: "viewer",
+		Impersonate: rest.ImpersonationConfig{UserName: "viewer"},
+	})
+	require.NoError(t, err)
+
+	adminClients, err := clients.ForConfig(admin)
+	require.NoError(t, err)
+
+	viewerClients, err := clients.ForConfig(viewer)
+	require.NoError(t, err)
+
+	require.NotSame(t, adminClients, viewerClients)
+	require.Equal(t, "viewer", viewerClients.RESTConfig.BearerToken)
+	require.Equal(t, "viewer", viewerClients.RESTConfig.Impersonate.UserName)
+
+	// Copies of the envconf.Config share the Clients.
+	viewerCopy := *viewer
+
+	shared, err := clients.ForConfig(&viewerCopy)
+	require.NoError(t, err)
+	require.Same(t, viewerClients, shared)
+}
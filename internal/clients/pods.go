@@ -0,0 +1,88 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+type (
+	// PodLogReader reads the logs of containers.
+	PodLogReader interface {
+		// PodLogs returns the logs of a container of the Pod. The container may only be omitted from the options if the
+		// Pod has a single container.
+		PodLogs(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) ([]byte, error)
+	}
+
+	// PodExecutor runs commands in containers.
+	PodExecutor interface {
+		// PodExec runs the command of the options in a container of the Pod and returns its standard output and error.
+		// When the container is omitted from the options, the command runs in the default container of the Pod. A
+		// command that exits with a non-zero status returns an error that wraps exec.CodeExitError.
+		PodExec(
+			ctx context.Context,
+			namespace, name string,
+			opts *corev1.PodExecOptions,
+		) (stdout, stderr []byte, err error)
+	}
+
+	// podClient uses the subresources of Pods served by the API server.
+	podClient struct {
+		restConfig *rest.Config
+		clientset  kubernetes.Interface
+	}
+)
+
+func (c podClient) PodLogs(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) ([]byte, error) {
+	return c.clientset.CoreV1().Pods(namespace).GetLogs(name, opts).DoRaw(ctx)
+}
+
+// PodExec streams the command over a websocket, falling back to SPDY for API servers that do not support websockets
+// for the exec subresource.
+func (c podClient) PodExec(
+	ctx context.Context,
+	namespace, name string,
+	opts *corev1.PodExecOptions,
+) (stdout, stderr []byte, err error) {
+	opts = opts.DeepCopy()
+	opts.Stdout = true
+	opts.Stderr = true
+
+	url := c.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(name).
+		SubResource("exec").
+		VersionedParams(opts, scheme.ParameterCodec).
+		URL()
+
+	websocketExecutor, err := remotecommand.NewWebSocketExecutor(c.restConfig, http.MethodGet, url.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	spdyExecutor, err := remotecommand.NewSPDYExecutor(c.restConfig, http.MethodPost, url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executor, err := remotecommand.NewFallbackExecutor(websocketExecutor, spdyExecutor, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stdoutBuf, stderrBuf bytes.Buffer
+
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: &stdoutBuf, Stderr: &stderrBuf})
+
+	return stdoutBuf.Bytes(), stderrBuf.Bytes(), err
+}
@@ -0,0 +1,78 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/kubernetes"
+)
+
+type (
+	// ProxyGetter sends HTTP requests to Pods and Services through the proxy subresource of the API server.
+	ProxyGetter interface {
+		// ProxyGet sends a GET request for the path to the port (a name or number) of the Pod or Service, which is
+		// identified by its resource (i.e. "pods" or "services"), and returns the status code and body of the response.
+		// An error is returned if no response is received or if the API server rejects the request itself (e.g. the
+		// proxy subresource is forbidden), so that it is not mistaken for a response of the Pod or Service. When the
+		// port is empty, the default port is used.
+		ProxyGet(
+			ctx context.Context,
+			resource, namespace, name, port, path string,
+		) (statusCode int, body []byte, err error)
+	}
+
+	// proxyClient uses the proxy subresources served by the API server.
+	proxyClient struct {
+		clientset  kubernetes.Interface
+		httpClient *http.Client
+	}
+)
+
+// ProxyGet sends the request with the HTTP client of the REST configuration rather than the REST client, which does not
+// return the status code of error responses that are not Statuses (e.g. a plain text 403 from the Pod or Service).
+func (c proxyClient) ProxyGet(
+	ctx context.Context,
+	resource, namespace, name, port, path string,
+) (statusCode int, body []byte, err error) {
+	url := c.clientset.CoreV1().RESTClient().Get().
+		Namespace(namespace).
+		Resource(resource).
+		Name(utilnet.JoinSchemeNamePort("", name, port)).
+		SubResource("proxy").
+		Suffix(path).
+		URL()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var status metav1.Status
+
+		// Statuses are returned by the API server when it rejects the request, while any other response with an error
+		// status comes from the Pod or Service and is returned as a status code.
+		if json.Unmarshal(body, &status) == nil && status.APIVersion == "v1" && status.Kind == "Status" {
+			return resp.StatusCode, body, apierrors.FromObject(&status)
+		}
+	}
+
+	return resp.StatusCode, body, nil
+}
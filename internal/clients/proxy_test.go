@@ -0,0 +1,51 @@
+package clients_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/DWSR/kubeassert-go/internal/clients"
+)
+
+func TestProxyGet(t *testing.T) {
+	mux := http.NewServeMux()
+
+	// The Service responds with 403 itself.
+	mux.HandleFunc("/api/v1/namespaces/apps/services/web:http/proxy/admin", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("admins only"))
+	})
+	// The API server rejects the request before it is proxied.
+	mux.HandleFunc("/api/v1/namespaces/apps/services/db:http/proxy/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(metav1.Status{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+			Status:   metav1.StatusFailure,
+			Reason:   metav1.StatusReasonForbidden,
+			Code:     http.StatusForbidden,
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	shared, err := clients.New(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	statusCode, body, err := shared.Proxy.ProxyGet(context.Background(), "services", "apps", "web", "http", "/admin")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, statusCode)
+	require.Equal(t, "admins only", string(body))
+
+	_, _, err = shared.Proxy.ProxyGet(context.Background(), "services", "apps", "db", "http", "/healthz")
+	require.True(t, apierrors.IsForbidden(err), err)
+}
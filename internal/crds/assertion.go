@@ -1,56 +1,32 @@
 package crds
 
 import (
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/e2e-framework/pkg/features"
 
 	"github.com/DWSR/kubeassert-go/internal/assertion"
-	helpers "github.com/DWSR/kubeassert-go/internal/assertionhelpers"
 )
 
 // CRDAssertion is an assertion for CustomResourceDefinitions.
 type CRDAssertion struct {
-	assertion.Assertion
-}
-
-func (ca CRDAssertion) clone() CRDAssertion {
-	return CRDAssertion{
-		Assertion: assertion.Clone(ca.Assertion),
-	}
-}
-
-// Exists asserts that exactly one CRD exists that matches the provided options.
-func (ca CRDAssertion) Exists() CRDAssertion {
-	stepFn := helpers.AsStepFunc(ca, exist(), 1, helpers.IntCompareFuncEqualTo, nil)
-
-	res := ca.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exists", stepFn))
-
-	return res
+	assertion.ResourceAssertion[extv1.CustomResourceDefinition, CRDAssertion]
 }
 
 // HasVersion asserts that exactly one CRD that matches the supplied options has the supplied version.
 func (ca CRDAssertion) HasVersion(crdVersion string) CRDAssertion {
-	stepFn := helpers.AsStepFunc(
-		ca,
-		hasVersion(crdVersion),
-		1,
-		helpers.IntCompareFuncNotEqualTo,
-		helpers.IntCompareFuncEqualTo,
-	)
-	res := ca.clone()
-	res.SetBuilder(res.GetBuilder().Assess("hasVersion", stepFn))
-
-	return res
+	return ca.ExactlyNMatch("hasVersion", 1, hasVersion(crdVersion))
 }
 
 // NewCRDAssertion creates a new CRDAssertion with the supplied options.
 func NewCRDAssertion(opts ...assertion.Option) CRDAssertion {
 	return CRDAssertion{
-		Assertion: assertion.NewAssertion(
-			append(
-				[]assertion.Option{assertion.WithBuilder(features.New("CRD").WithLabel("type", "customresourcedefinition"))},
-				opts...,
-			)...,
+		ResourceAssertion: assertion.NewResourceAssertion(
+			extv1.SchemeGroupVersion.WithResource("customresourcedefinitions"),
+			func(ra assertion.ResourceAssertion[extv1.CustomResourceDefinition, CRDAssertion]) CRDAssertion {
+				return CRDAssertion{ResourceAssertion: ra}
+			},
+			features.New("CRD").WithLabel("type", "customresourcedefinition"),
+			opts...,
 		),
 	}
 }
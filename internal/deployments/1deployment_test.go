@@ -1,14 +1,23 @@
 package deployments_test
 
 import (
+	"context"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+	"sigs.k8s.io/e2e-framework/pkg/features"
 
 	"github.com/DWSR/kubeassert-go/internal/assertion"
 	helpers "github.com/DWSR/kubeassert-go/internal/assertionhelpers"
 	"github.com/DWSR/kubeassert-go/internal/deployments"
+	"github.com/DWSR/kubeassert-go/internal/metrics"
+	"github.com/DWSR/kubeassert-go/internal/report"
 	"github.com/DWSR/kubeassert-go/internal/testhelpers"
 )
 
@@ -72,6 +81,47 @@ func Test_1Deployment_Success(t *testing.T) {
 				).Exists().IsAvailable()
 			},
 		},
+		{
+			Name: "IsAvailable_Watch",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithWatch(),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+					),
+				).Exists().IsAvailable()
+			},
+		},
+		{
+			Name: "IsAvailable_Consistently",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+					),
+				).Exists().IsAvailable().Consistently(3 * time.Second).IsAvailable()
+			},
+		},
+		{
+			Name: "IsAvailable_ConsistentlyWatch",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithWatch(),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+					),
+				).Exists().IsAvailable().Consistently(3 * time.Second).IsAvailable()
+			},
+		},
 		{
 			Name: "IsSystemClusterCritical_Labels",
 			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
@@ -228,6 +278,52 @@ func Test_1Deployment_Success(t *testing.T) {
 				).Exists().HasCPURequests()
 			},
 		},
+		{
+			Name: "NoneExist_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+				).NoneExist()
+			},
+		},
+		{
+			Name: "IsDeleted_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+						helpers.DeleteResourceFromPathWithNamespaceFromEnv(deploymentPath),
+					),
+				).IsDeleted()
+			},
+		},
+		{
+			Name: "IsNotAvailable_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+					),
+				).Exists().IsNotAvailable()
+			},
+		},
+		{
+			Name: "NoneAreSystemClusterCritical_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(badDeploymentPath),
+					),
+				).Exists().NoneAreSystemClusterCritical()
+			},
+		},
 	}
 
 	testhelpers.TestSuccessfulAsserts(t, testEnv, asserts...)
@@ -259,6 +355,34 @@ func Test_1Deployment_Fail(t *testing.T) {
 				).Exists()
 			},
 		},
+		{
+			Name: "Exists_Watch",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithRequireT(t),
+					assertion.WithWatch(),
+					assertion.WithTimeout(500*time.Millisecond),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+				).Exists()
+			},
+		},
+		{
+			Name: "NoneExist_Consistently",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithRequireT(t),
+					assertion.WithTimeout(500*time.Millisecond),
+					assertion.WithInterval(100*time.Millisecond),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+					),
+				).Exists().Consistently(2 * time.Second).NoneExist()
+			},
+		},
 		{
 			Name: "IsAvailable_Labels",
 			FailingAssert: func(t require.TestingT) assertion.Assertion {
@@ -439,7 +563,204 @@ func Test_1Deployment_Fail(t *testing.T) {
 				).Exists().HasCPURequests()
 			},
 		},
+		{
+			Name: "NoneExist_Name",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithRequireT(t),
+					assertion.WithTimeout(500*time.Millisecond),
+					assertion.WithInterval(100*time.Millisecond),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+					),
+				).NoneExist()
+			},
+		},
+		{
+			Name: "NoneAreSystemClusterCritical_Name",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithRequireT(t),
+					assertion.WithTimeout(500*time.Millisecond),
+					assertion.WithInterval(100*time.Millisecond),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+					),
+				).NoneAreSystemClusterCritical()
+			},
+		},
 	}
 
 	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
 }
+
+func Test_1Deployment_FailureDiagnostics(t *testing.T) {
+	mockT := &testhelpers.MockT{}
+
+	assert := deployments.NewDeploymentAssertion(
+		assertion.WithRequireT(mockT),
+		assertion.WithTimeout(500*time.Millisecond),
+		assertion.WithInterval(100*time.Millisecond),
+		assertion.WithResourceNamespaceFromTestEnv(),
+		assertion.WithResourceName("test-deployment"),
+		assertion.WithSetup(
+			helpers.CreateResourceFromPathWithNamespaceFromEnv(badDeploymentPath),
+		),
+	).HasCPURequests()
+
+	testEnv.Test(t, assertion.AsFeature(assert))
+
+	require.True(t, mockT.Failed)
+	require.Contains(t, strings.Join(mockT.Errors, "\n"), `check "exactlyNHaveCPURequests"`)
+	require.Contains(t, strings.Join(mockT.Errors, "\n"), "/test-deployment: not satisfied")
+}
+
+func Test_1Deployment_Monitor(t *testing.T) {
+	monitor := deployments.NewDeploymentAssertion(
+		assertion.WithInterval(100*time.Millisecond),
+		assertion.WithResourceNamespaceFromTestEnv(),
+		assertion.WithResourceName("test-deployment"),
+	).Exists().Monitor()
+
+	assert := deployments.NewDeploymentAssertion(
+		assertion.WithResourceNamespaceFromTestEnv(),
+		assertion.WithResourceName("test-deployment"),
+		assertion.WithSetup(
+			helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+			helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+			monitor.Start(),
+		),
+		assertion.WithTeardown(monitor.Stop()),
+	).IsAvailable()
+
+	testEnv.Test(t, assertion.AsFeature(assert))
+
+	require.NoError(t, monitor.Err())
+	require.Empty(t, monitor.Violations())
+}
+
+func Test_1Deployment_MonitorViolated(t *testing.T) {
+	mockT := &testhelpers.MockT{}
+
+	monitor := deployments.NewDeploymentAssertion(
+		assertion.WithRequireT(mockT),
+		assertion.WithInterval(100*time.Millisecond),
+		assertion.WithResourceNamespaceFromTestEnv(),
+		assertion.WithResourceName("test-deployment"),
+	).NoneExist().Monitor()
+
+	assert := deployments.NewDeploymentAssertion(
+		assertion.WithResourceNamespaceFromTestEnv(),
+		assertion.WithResourceName("test-deployment"),
+		assertion.WithSetup(
+			monitor.Start(),
+			helpers.Sleep(500*time.Millisecond),
+			helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+			helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+		),
+		assertion.WithTeardown(monitor.Stop()),
+	).Exists()
+
+	testEnv.Test(t, assertion.AsFeature(assert))
+
+	require.True(t, mockT.Failed)
+	require.ErrorIs(t, monitor.Err(), assertion.ErrMonitorViolated)
+	require.Len(t, monitor.Violations(), 1)
+	require.Contains(t, strings.Join(mockT.Errors, "\n"), `check "noneExist"`)
+}
+
+func Test_1Deployment_Evaluate(t *testing.T) {
+	passing := features.New("Evaluate_Passes").
+		Setup(helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath)).
+		Setup(helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath)).
+		Assess("evaluate", func(ctx context.Context, t *testing.T, cfg *envconf.Config) context.Context {
+			res, err := deployments.NewDeploymentAssertion(
+				assertion.WithResourceNamespace(cfg.Namespace()),
+				assertion.WithResourceName("test-deployment"),
+			).Exists().IsAvailable().Evaluate(ctx, cfg.Client().RESTConfig())
+
+			require.NoError(t, err)
+			require.True(t, res.Passed(), res.String())
+			require.Len(t, res.Checks, 2)
+
+			return ctx
+		}).
+		Feature()
+
+	failing := features.New("Evaluate_Fails").
+		Setup(helpers.CreateResourceFromPathWithNamespaceFromEnv(badDeploymentPath)).
+		Assess("evaluate", func(ctx context.Context, t *testing.T, cfg *envconf.Config) context.Context {
+			res, err := deployments.NewDeploymentAssertion(
+				assertion.WithTimeout(500*time.Millisecond),
+				assertion.WithInterval(100*time.Millisecond),
+				assertion.WithResourceNamespace(cfg.Namespace()),
+				assertion.WithResourceName("test-deployment"),
+			).HasCPURequests().Exists().Evaluate(ctx, cfg.Client().RESTConfig())
+
+			require.NoError(t, err)
+			require.False(t, res.Passed())
+			require.False(t, res.Checks[0].Passed)
+			require.True(t, res.Checks[1].Passed)
+
+			return ctx
+		}).
+		Feature()
+
+	testEnv.Test(t, passing, failing)
+}
+
+func Test_1Deployment_Reporter(t *testing.T) {
+	reporter := report.NewReporter()
+
+	assert := deployments.NewDeploymentAssertion(
+		assertion.WithReporter(reporter),
+		assertion.WithResourceNamespaceFromTestEnv(),
+		assertion.WithResourceName("test-deployment"),
+		assertion.WithSetup(
+			helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+			helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+		),
+	).Exists().IsAvailable()
+
+	testEnv.Test(t, assertion.AsFeature(assert))
+
+	records := reporter.Records()
+	require.Len(t, records, 2)
+	require.Equal(t, "Deployment", records[0].Kind)
+	require.Equal(t, "exactlyNExist", records[0].Check)
+	require.Equal(t, "exactlyNAreAvailable", records[1].Check)
+	require.Contains(t, records[1].FieldSelector, "metadata.name=test-deployment")
+	require.False(t, reporter.Failed())
+}
+
+func Test_1Deployment_Telemetry(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+
+	assert := deployments.NewDeploymentAssertion(
+		assertion.WithTracerProvider(provider),
+		assertion.WithResourceNamespaceFromTestEnv(),
+		assertion.WithResourceName("test-deployment"),
+		assertion.WithSetup(
+			helpers.CreateResourceFromPathWithNamespaceFromEnv(deploymentPath),
+			helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+		),
+	).Exists()
+
+	testEnv.Test(t, assertion.AsFeature(assert))
+
+	names := make([]string, 0, len(spans.Ended()))
+	for _, span := range spans.Ended() {
+		names = append(names, span.Name())
+	}
+
+	require.Contains(t, names, "kubeassert.check exactlyNExist")
+	require.Contains(t, names, "kubeassert.attempt")
+	require.Contains(t, names, "kubeassert.list")
+	require.Positive(t, testutil.CollectAndCount(metrics.CheckDuration))
+	require.Positive(t, testutil.CollectAndCount(metrics.ListDuration))
+}
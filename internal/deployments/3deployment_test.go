@@ -1,6 +1,7 @@
 package deployments_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -27,6 +28,20 @@ func Test_3Deployments_Success(t *testing.T) {
 				).ExactlyNExist(3)
 			},
 		},
+		{
+			Name: "ExactlyNExist_LabelSelector",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				deployNames := generateDeploymentNames()
+
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithLabelSelector(
+						fmt.Sprintf("app.kubernetes.io/name in (%s,unused),!canary", deployNames[0]),
+					),
+					assertion.WithSetup(createGoodDeploys(deployNames)...),
+				).ExactlyNExist(3)
+			},
+		},
 		{
 			Name: "AtLeastNExist",
 			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
@@ -271,6 +286,21 @@ func Test_3Deployments_Fail(t *testing.T) {
 				).ExactlyNExist(2)
 			},
 		},
+		{
+			Name: "NoneExist_LabelSelector",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				deployNames := generateDeploymentNames()
+
+				return deployments.NewDeploymentAssertion(
+					assertion.WithRequireT(t),
+					assertion.WithTimeout(500*time.Millisecond),
+					assertion.WithInterval(100*time.Millisecond),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithLabelSelector("app.kubernetes.io/component"),
+					assertion.WithSetup(createBadDeploys(deployNames)...),
+				).NoneExist()
+			},
+		},
 		{
 			Name: "AtLeastNExist",
 			FailingAssert: func(t require.TestingT) assertion.Assertion {
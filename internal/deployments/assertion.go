@@ -1,47 +1,16 @@
 package deployments
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
 	"sigs.k8s.io/e2e-framework/pkg/features"
 
 	"github.com/DWSR/kubeassert-go/internal/assertion"
-	helpers "github.com/DWSR/kubeassert-go/internal/assertionhelpers"
 )
 
-// DeploymentAssertion is a wrapper around assertion.Assertion that provides a set of assertion functions for
+// DeploymentAssertion is a wrapper around assertion.ResourceAssertion that provides a set of assertion functions for
 // Deployments.
 type DeploymentAssertion struct {
-	assertion.Assertion
-}
-
-func (da DeploymentAssertion) clone() DeploymentAssertion {
-	return DeploymentAssertion{
-		Assertion: assertion.Clone(da.Assertion),
-	}
-}
-
-// Exists asserts that exactly one Deployment exists in the cluster that matches the provided options.
-func (da DeploymentAssertion) Exists() DeploymentAssertion {
-	return da.ExactlyNExist(1)
-}
-
-// ExactlyNExist asserts that exactly N Deployments exist in the cluster that match the provided options.
-func (da DeploymentAssertion) ExactlyNExist(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(da, exist(), count, helpers.IntCompareFuncEqualTo, nil)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNExist", stepFn))
-
-	return res
-}
-
-// AtLeastNExist asserts that at least N Deployments exist in the cluster that match the provided options.
-func (da DeploymentAssertion) AtLeastNExist(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(da, exist(), count, helpers.IntCompareFuncGreaterThanOrEqualTo, nil)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNExist", stepFn))
-
-	return res
+	assertion.ResourceAssertion[appsv1.Deployment, DeploymentAssertion]
 }
 
 // IsAvailable asserts that exactly one Deployment is available in the cluster that matches the provided options.
@@ -51,35 +20,31 @@ func (da DeploymentAssertion) IsAvailable() DeploymentAssertion {
 
 // ExactlyNAreAvailable asserts that exactly N Deployments are available in the cluster that match the provided options.
 func (da DeploymentAssertion) ExactlyNAreAvailable(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		areAvailable(),
-		count,
-		helpers.IntCompareFuncNotEqualTo,
-		helpers.IntCompareFuncEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNAreAvailable", stepFn))
-
-	return res
+	return da.ExactlyNMatch("exactlyNAreAvailable", count, isAvailable)
 }
 
 // AtLeastNAreAvailable asserts that at least N Deployments are available in the cluster that match the provided
 // options.
 func (da DeploymentAssertion) AtLeastNAreAvailable(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		areAvailable(),
-		count,
-		helpers.IntCompareFuncLessThan,
-		helpers.IntCompareFuncGreaterThanOrEqualTo,
-	)
+	return da.AtLeastNMatch("atLeastNAreAvailable", count, isAvailable)
+}
+
+// IsNotAvailable asserts that exactly one Deployment is not available in the cluster that matches the provided
+// options.
+func (da DeploymentAssertion) IsNotAvailable() DeploymentAssertion {
+	return da.ExactlyNAreNotAvailable(1)
+}
 
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNAreAvailable", stepFn))
+// ExactlyNAreNotAvailable asserts that exactly N Deployments are not available in the cluster that match the provided
+// options.
+func (da DeploymentAssertion) ExactlyNAreNotAvailable(count int) DeploymentAssertion {
+	return da.ExactlyNMatch("exactlyNAreNotAvailable", count, assertion.Not(isAvailable))
+}
 
-	return res
+// AtLeastNAreNotAvailable asserts that at least N Deployments are not available in the cluster that match the provided
+// options.
+func (da DeploymentAssertion) AtLeastNAreNotAvailable(count int) DeploymentAssertion {
+	return da.AtLeastNMatch("atLeastNAreNotAvailable", count, assertion.Not(isAvailable))
 }
 
 // IsSystemClusterCritical asserts that exactly one Deployment is system cluster critical in the cluster that matches
@@ -91,35 +56,19 @@ func (da DeploymentAssertion) IsSystemClusterCritical() DeploymentAssertion {
 // ExactlyNAreSystemClusterCritical asserts that exactly N Deployments are system cluster critical in the cluster that
 // match the provided options.
 func (da DeploymentAssertion) ExactlyNAreSystemClusterCritical(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		areSystemClusterCritical(),
-		count,
-		helpers.IntCompareFuncNotEqualTo,
-		helpers.IntCompareFuncEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNAreSystemClusterCritical", stepFn))
-
-	return res
+	return da.ExactlyNMatch("exactlyNAreSystemClusterCritical", count, isSystemClusterCritical)
 }
 
 // AtLeastNAreSystemClusterCritical asserts that at least N Deployments are system cluster critical in the cluster that
 // match the provided options.
 func (da DeploymentAssertion) AtLeastNAreSystemClusterCritical(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		areSystemClusterCritical(),
-		count,
-		helpers.IntCompareFuncLessThan,
-		helpers.IntCompareFuncGreaterThanOrEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNAreSystemClusterCritical", stepFn))
+	return da.AtLeastNMatch("atLeastNAreSystemClusterCritical", count, isSystemClusterCritical)
+}
 
-	return res
+// NoneAreSystemClusterCritical asserts that none of the Deployments in the cluster that match the provided options are
+// system cluster critical.
+func (da DeploymentAssertion) NoneAreSystemClusterCritical() DeploymentAssertion {
+	return da.NoneMatch("noneAreSystemClusterCritical", isSystemClusterCritical)
 }
 
 // HasNoCPULimits asserts that exactly one Deployment has no CPU limits in the cluster that match the provided options.
@@ -130,35 +79,13 @@ func (da DeploymentAssertion) HasNoCPULimits() DeploymentAssertion {
 // ExactlyNHaveNoCPULimits asserts that exactly N Deployments have no CPU limits in the cluster that match the provided
 // options.
 func (da DeploymentAssertion) ExactlyNHaveNoCPULimits(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveNoCPULimits(),
-		count,
-		helpers.IntCompareFuncNotEqualTo,
-		helpers.IntCompareFuncEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNHaveNoCPULimits", stepFn))
-
-	return res
+	return da.ExactlyNMatch("exactlyNHaveNoCPULimits", count, hasNoCPULimits)
 }
 
 // AtLeastNHaveNoCPULimits asserts that at least N Deployments have no CPU limits in the cluster that match the provided
 // options.
 func (da DeploymentAssertion) AtLeastNHaveNoCPULimits(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveNoCPULimits(),
-		count,
-		helpers.IntCompareFuncLessThan,
-		helpers.IntCompareFuncGreaterThanOrEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNHaveNoCPULimits", stepFn))
-
-	return res
+	return da.AtLeastNMatch("atLeastNHaveNoCPULimits", count, hasNoCPULimits)
 }
 
 // HasMemoryLimitsEqualToRequests asserts that exactly one Deployment has memory limits set equal to requests in the
@@ -170,35 +97,13 @@ func (da DeploymentAssertion) HasMemoryLimitsEqualToRequests() DeploymentAsserti
 // ExactlyNHaveMemoryLimitsEqualToRequests asserts that exactly N Deployments have memory limits set equal to requests
 // in the cluster that match the provided options.
 func (da DeploymentAssertion) ExactlyNHaveMemoryLimitsEqualToRequests(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveMemoryLimitsEqualToRequests(),
-		count,
-		helpers.IntCompareFuncNotEqualTo,
-		helpers.IntCompareFuncEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNHaveMemoryLimitsEqualToRequests", stepFn))
-
-	return res
+	return da.ExactlyNMatch("exactlyNHaveMemoryLimitsEqualToRequests", count, hasMemoryLimitsEqualToRequests)
 }
 
 // AtLeastNHaveMemoryLimitsEqualToRequests asserts that at least N Deployments have memory limits set equal to requests
 // in the cluster that match the provided options.
 func (da DeploymentAssertion) AtLeastNHaveMemoryLimitsEqualToRequests(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveMemoryLimitsEqualToRequests(),
-		count,
-		helpers.IntCompareFuncLessThan,
-		helpers.IntCompareFuncGreaterThanOrEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNHaveMemoryLimitsEqualToRequests", stepFn))
-
-	return res
+	return da.AtLeastNMatch("atLeastNHaveMemoryLimitsEqualToRequests", count, hasMemoryLimitsEqualToRequests)
 }
 
 // HasMemoryLimits asserts that exactly one Deployment has memory limits in the cluster that match the provided options.
@@ -209,35 +114,13 @@ func (da DeploymentAssertion) HasMemoryLimits() DeploymentAssertion {
 // ExactlyNHaveMemoryLimits asserts that exactly N Deployments have memory limits in the cluster that match the provided
 // options.
 func (da DeploymentAssertion) ExactlyNHaveMemoryLimits(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveMemoryLimits(),
-		count,
-		helpers.IntCompareFuncNotEqualTo,
-		helpers.IntCompareFuncEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNHaveMemoryLimits", stepFn))
-
-	return res
+	return da.ExactlyNMatch("exactlyNHaveMemoryLimits", count, hasMemoryLimits)
 }
 
 // AtLeastNHaveMemoryLimits asserts that at least N Deployments have memory limits in the cluster that match the
 // provided options.
 func (da DeploymentAssertion) AtLeastNHaveMemoryLimits(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveMemoryLimits(),
-		count,
-		helpers.IntCompareFuncLessThan,
-		helpers.IntCompareFuncGreaterThanOrEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNHaveMemoryLimits", stepFn))
-
-	return res
+	return da.AtLeastNMatch("atLeastNHaveMemoryLimits", count, hasMemoryLimits)
 }
 
 // HasMemoryRequests asserts that exactly one Deployment has memory requests in the cluster that match the provided
@@ -249,35 +132,13 @@ func (da DeploymentAssertion) HasMemoryRequests() DeploymentAssertion {
 // ExactlyNHaveMemoryRequests asserts that exactly N Deployments have memory requests in the cluster that match the
 // provided options.
 func (da DeploymentAssertion) ExactlyNHaveMemoryRequests(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveMemoryRequests(),
-		count,
-		helpers.IntCompareFuncNotEqualTo,
-		helpers.IntCompareFuncEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNHaveMemoryRequests", stepFn))
-
-	return res
+	return da.ExactlyNMatch("exactlyNHaveMemoryRequests", count, hasMemoryRequests)
 }
 
 // AtLeastNHaveMemoryRequests asserts that at least N Deployments have memory requests in the cluster that match the
 // provided options.
 func (da DeploymentAssertion) AtLeastNHaveMemoryRequests(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveMemoryRequests(),
-		count,
-		helpers.IntCompareFuncLessThan,
-		helpers.IntCompareFuncGreaterThanOrEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNHaveMemoryRequests", stepFn))
-
-	return res
+	return da.AtLeastNMatch("atLeastNHaveMemoryRequests", count, hasMemoryRequests)
 }
 
 // HasCPURequests asserts that exactly one Deployment has CPU requests in the cluster that match the provided options.
@@ -288,45 +149,25 @@ func (da DeploymentAssertion) HasCPURequests() DeploymentAssertion {
 // ExactlyNHaveCPURequests asserts that exactly N Deployments have CPU requests in the cluster that match the provided
 // options.
 func (da DeploymentAssertion) ExactlyNHaveCPURequests(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveCPURequests(),
-		count,
-		helpers.IntCompareFuncNotEqualTo,
-		helpers.IntCompareFuncEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNHaveCPURequests", stepFn))
-
-	return res
+	return da.ExactlyNMatch("exactlyNHaveCPURequests", count, hasCPURequests)
 }
 
 // AtLeastNHaveCPURequests asserts that at least N Deployments have CPU requests in the cluster that match the provided
 // options.
 func (da DeploymentAssertion) AtLeastNHaveCPURequests(count int) DeploymentAssertion {
-	stepFn := helpers.AsStepFunc(
-		da,
-		haveCPURequests(),
-		count,
-		helpers.IntCompareFuncLessThan,
-		helpers.IntCompareFuncGreaterThanOrEqualTo,
-	)
-
-	res := da.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNHaveCPURequests", stepFn))
-
-	return res
+	return da.AtLeastNMatch("atLeastNHaveCPURequests", count, hasCPURequests)
 }
 
 // NewDeploymentAssertion creates a new DeploymentAssertion with the provided options.
 func NewDeploymentAssertion(opts ...assertion.Option) DeploymentAssertion {
 	return DeploymentAssertion{
-		Assertion: assertion.NewAssertion(
-			append(
-				[]assertion.Option{assertion.WithBuilder(features.New("Deployment").WithLabel("type", "deployment"))},
-				opts...,
-			)...,
+		ResourceAssertion: assertion.NewResourceAssertion(
+			appsv1.SchemeGroupVersion.WithResource("deployments"),
+			func(ra assertion.ResourceAssertion[appsv1.Deployment, DeploymentAssertion]) DeploymentAssertion {
+				return DeploymentAssertion{ResourceAssertion: ra}
+			},
+			features.New("Deployment").WithLabel("type", "deployment"),
+			opts...,
 		),
 	}
 }
@@ -0,0 +1,185 @@
+package events
+
+import (
+	"context"
+	"maps"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+	"sigs.k8s.io/e2e-framework/pkg/features"
+	"sigs.k8s.io/e2e-framework/pkg/types"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+)
+
+type (
+	// EventAssertion is a wrapper around assertion.ResourceAssertion that provides a set of assertion functions for
+	// Events. Events are listed with the events.k8s.io/v1 API, which also serves the Events created with the core/v1
+	// API.
+	EventAssertion struct {
+		assertion.ResourceAssertion[eventsv1.Event, EventAssertion]
+	}
+
+	// ObjectLister lists the objects selected by an assertion. It is implemented by every assertion (e.g.
+	// deployments.DeploymentAssertion).
+	ObjectLister interface {
+		ListObjects(ctx context.Context, cfg *envconf.Config) ([]unstructured.Unstructured, error)
+	}
+)
+
+// WithType selects Events of the type (i.e. Normal or Warning).
+func WithType(eventType string) assertion.Option {
+	return withFields(map[string]string{"type": eventType})
+}
+
+// WithReason selects Events with the reason (e.g. BackOff).
+func WithReason(reason string) assertion.Option {
+	return withFields(map[string]string{"reason": reason})
+}
+
+// WithRegarding selects Events about the object of the kind with the name (e.g. a Pod). Use it with
+// assertion.WithResourceNamespace to select Events about an object in a namespace.
+func WithRegarding(kind, name string) assertion.Option {
+	return withFields(map[string]string{"regarding.kind": kind, "regarding.name": name})
+}
+
+func withFields(fields map[string]string) assertion.Option {
+	return func(a assertion.Assertion) {
+		newFields := a.GetFields()
+		maps.Copy(newFields, fields)
+		assertion.WithResourceFields(newFields)(a)
+	}
+}
+
+// Regarding narrows the selected Events to those about the objects selected by another assertion (e.g. the Pods of a
+// PodAssertion) when the Events are listed.
+func (ea EventAssertion) Regarding(lister ObjectLister) EventAssertion {
+	return ea.FilteredBy(
+		func(ctx context.Context, cfg *envconf.Config) (assertion.Predicate[unstructured.Unstructured], error) {
+			objs, err := lister.ListObjects(ctx, cfg)
+			if err != nil {
+				return nil, err
+			}
+
+			return regardingAny(objs), nil
+		},
+	)
+}
+
+// Since narrows the selected Events to those last observed at or after the time.
+func (ea EventAssertion) Since(since time.Time) EventAssertion {
+	return ea.FilteredBy(
+		func(context.Context, *envconf.Config) (assertion.Predicate[unstructured.Unstructured], error) {
+			return seenSince(since), nil
+		},
+	)
+}
+
+// SinceStart narrows the selected Events to those last observed after the e2e-framework Feature started, so that
+// Events left over from earlier tests are ignored. The time is recorded before any other step of the Feature runs, so
+// Events emitted by its setup steps (e.g. those added with assertion.WithSetup) are selected. Every Event is selected
+// when the assertion is evaluated outside of a Feature.
+func (ea EventAssertion) SinceStart() EventAssertion {
+	var start atomic.Pointer[time.Time]
+
+	res := ea.FilteredBy(
+		func(context.Context, *envconf.Config) (assertion.Predicate[unstructured.Unstructured], error) {
+			since := start.Load()
+			if since == nil {
+				return func(unstructured.Unstructured) bool { return true }, nil
+			}
+
+			return seenSince(*since), nil
+		},
+	)
+
+	recordStart := func(ctx context.Context, _ *testing.T, _ *envconf.Config) context.Context {
+		now := time.Now()
+		start.Store(&now)
+
+		return ctx
+	}
+
+	res.SetBuilder(prependSetup(res.GetBuilder(), recordStart))
+
+	return res
+}
+
+// prependSetup returns a features.FeatureBuilder for the same Feature as builder with a setup step that runs before
+// every other step (e.g. those added with assertion.WithSetup).
+func prependSetup(builder *features.FeatureBuilder, setup features.Func) *features.FeatureBuilder {
+	feature := builder.Feature()
+
+	var res *features.FeatureBuilder
+	if described, ok := feature.(types.DescribableFeature); ok {
+		res = features.NewWithDescription(feature.Name(), described.Description())
+	} else {
+		res = features.New(feature.Name())
+	}
+
+	for key, values := range feature.Labels() {
+		for _, value := range values {
+			res = res.WithLabel(key, value)
+		}
+	}
+
+	res = res.Setup(setup)
+
+	for _, step := range feature.Steps() {
+		if described, ok := step.(types.DescribableStep); ok {
+			res = res.WithStepDescription(step.Name(), described.Description(), step.Level(), step.Func())
+		} else {
+			res = res.WithStep(step.Name(), step.Level(), step.Func())
+		}
+	}
+
+	return res
+}
+
+// NoWarnings asserts that none of the Events in the cluster that match the provided options are Warnings.
+func (ea EventAssertion) NoWarnings() EventAssertion {
+	return ea.NoneMatch("noWarnings", isWarning)
+}
+
+// NoneWithReason asserts that none of the Events in the cluster that match the provided options have the reason (e.g.
+// FailedScheduling).
+func (ea EventAssertion) NoneWithReason(reason string) EventAssertion {
+	return ea.NoneMatch("noneWithReason", hasReason(reason))
+}
+
+// WasEmitted asserts that at least one Event with the reason (e.g. ScalingReplicaSet) is in the cluster that matches
+// the provided options.
+func (ea EventAssertion) WasEmitted(reason string) EventAssertion {
+	return ea.AtLeastNWereEmitted(1, reason)
+}
+
+// ExactlyNWereEmitted asserts that exactly N Events with the reason are in the cluster that match the provided options.
+// Other Events may also match the provided options. Repeated occurrences of an Event that are aggregated into a series
+// count once.
+func (ea EventAssertion) ExactlyNWereEmitted(count int, reason string) EventAssertion {
+	return ea.ExactlyNWhere("exactlyNWereEmitted", count, hasReason(reason))
+}
+
+// AtLeastNWereEmitted asserts that at least N Events with the reason are in the cluster that match the provided
+// options. Other Events may also match the provided options.
+func (ea EventAssertion) AtLeastNWereEmitted(count int, reason string) EventAssertion {
+	return ea.AtLeastNWhere("atLeastNWereEmitted", count, hasReason(reason))
+}
+
+// NewEventAssertion creates a new EventAssertion with the provided options.
+func NewEventAssertion(opts ...assertion.Option) EventAssertion {
+	return EventAssertion{
+		ResourceAssertion: assertion.NewResourceAssertion(
+			eventsv1.SchemeGroupVersion.WithResource("events"),
+			func(ra assertion.ResourceAssertion[eventsv1.Event, EventAssertion]) EventAssertion {
+				return EventAssertion{ResourceAssertion: ra}
+			},
+			features.New("Event").WithLabel("type", "event"),
+			opts...,
+		),
+	}
+}
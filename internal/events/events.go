@@ -0,0 +1,84 @@
+// events contains assertions for Kubernetes Events.
+package events
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+)
+
+func isWarning(event eventsv1.Event) bool {
+	return event.Type == corev1.EventTypeWarning
+}
+
+func hasReason(reason string) assertion.Predicate[eventsv1.Event] {
+	return func(event eventsv1.Event) bool {
+		return event.Reason == reason
+	}
+}
+
+// lastSeen returns the last time that the Event was observed. Events created with the core/v1 API only set the
+// deprecated timestamps.
+func lastSeen(event eventsv1.Event) time.Time {
+	seen := event.CreationTimestamp.Time
+
+	for _, observed := range []time.Time{
+		event.EventTime.Time,
+		event.DeprecatedLastTimestamp.Time,
+	} {
+		if observed.After(seen) {
+			seen = observed
+		}
+	}
+
+	if event.Series != nil && event.Series.LastObservedTime.After(seen) {
+		seen = event.Series.LastObservedTime.Time
+	}
+
+	return seen
+}
+
+// seenSince returns a Predicate that is satisfied by Events that were last observed at or after the time. As the
+// deprecated timestamps only have a precision of one second, the time is truncated to the second.
+func seenSince(since time.Time) assertion.Predicate[unstructured.Unstructured] {
+	since = since.Truncate(time.Second)
+
+	return func(obj unstructured.Unstructured) bool {
+		var event eventsv1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &event); err != nil {
+			return false
+		}
+
+		return !lastSeen(event).Before(since)
+	}
+}
+
+// regardingAny returns a Predicate that is satisfied by Events about any of the objects.
+func regardingAny(objs []unstructured.Unstructured) assertion.Predicate[unstructured.Unstructured] {
+	return func(event unstructured.Unstructured) bool {
+		regarding, _, _ := unstructured.NestedStringMap(event.Object, "regarding")
+
+		for _, obj := range objs {
+			if refersTo(regarding, obj) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// refersTo returns true if the object reference refers to the object, by UID when both have one and otherwise by kind,
+// namespace and name.
+func refersTo(ref map[string]string, obj unstructured.Unstructured) bool {
+	if ref["uid"] != "" && obj.GetUID() != "" {
+		return ref["uid"] == string(obj.GetUID())
+	}
+
+	return ref["kind"] == obj.GetKind() && ref["namespace"] == obj.GetNamespace() && ref["name"] == obj.GetName()
+}
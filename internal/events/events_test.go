@@ -0,0 +1,202 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/e2e-framework/pkg/env"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/events"
+	"github.com/DWSR/kubeassert-go/internal/fakecluster"
+	"github.com/DWSR/kubeassert-go/internal/pods"
+	"github.com/DWSR/kubeassert-go/internal/testhelpers"
+)
+
+const (
+	podsPath   = "./testdata/pods.yaml"
+	eventsPath = "./testdata/events.yaml"
+	namespace  = "events"
+)
+
+func newTestEnv(t *testing.T, paths ...string) (env.Environment, *fakecluster.Cluster) {
+	t.Helper()
+
+	cluster, err := fakecluster.NewFromFiles(paths...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, cluster.Close()) })
+
+	return env.NewWithConfig(cluster.Config()), cluster
+}
+
+func podsWithApp(app string) pods.PodAssertion {
+	return pods.NewPodAssertion(
+		assertion.WithResourceNamespace(namespace),
+		assertion.WithResourceLabels(map[string]string{"app": app}),
+	)
+}
+
+func Test_Events_Success(t *testing.T) {
+	testEnv, _ := newTestEnv(t, podsPath, eventsPath)
+
+	asserts := []testhelpers.SuccessfulAssert{
+		{
+			Name: "WasEmitted",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return events.NewEventAssertion(
+					assertion.WithResourceNamespace(namespace),
+					events.WithRegarding("Pod", "web-1"),
+				).WasEmitted("Pulled").WasEmitted("Started")
+			},
+		},
+		{
+			Name: "ExactlyNWereEmitted",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return events.NewEventAssertion(events.WithType("Normal")).ExactlyNWereEmitted(1, "Started")
+			},
+		},
+		{
+			Name: "AtLeastNWereEmitted",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return events.NewEventAssertion(events.WithReason("FailedMount")).AtLeastNWereEmitted(1, "FailedMount")
+			},
+		},
+		{
+			Name: "NoWarnings_Regarding",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return events.NewEventAssertion().Regarding(podsWithApp("web")).NoWarnings().WasEmitted("Pulled")
+			},
+		},
+		{
+			Name: "NoneWithReason",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return events.NewEventAssertion(events.WithType("Warning")).
+					NoneWithReason("BackOff").
+					NoneWithReason("FailedScheduling")
+			},
+		},
+		{
+			Name: "NoWarnings_Since",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return events.NewEventAssertion().
+					Since(time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)).
+					NoWarnings().
+					ExactlyNWereEmitted(1, "Pulled").
+					ExactlyNExist(2)
+			},
+		},
+	}
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, asserts...)
+}
+
+func Test_Events_Fail(t *testing.T) {
+	testEnv, _ := newTestEnv(t, podsPath, eventsPath)
+
+	options := func(t require.TestingT) []assertion.Option {
+		return []assertion.Option{
+			assertion.WithRequireT(t),
+			assertion.WithTimeout(100 * time.Millisecond),
+			assertion.WithInterval(10 * time.Millisecond),
+		}
+	}
+
+	asserts := []testhelpers.FailingAssert{
+		{
+			Name: "NoWarnings",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return events.NewEventAssertion(options(t)...).NoWarnings()
+			},
+		},
+		{
+			Name: "NoneWithReason",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return events.NewEventAssertion(options(t)...).Regarding(podsWithApp("db")).NoneWithReason("FailedMount")
+			},
+		},
+		{
+			Name: "WasEmitted_Regarding",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return events.NewEventAssertion(options(t)...).Regarding(podsWithApp("db")).WasEmitted("Started")
+			},
+		},
+	}
+
+	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
+}
+
+// emitKilling creates an Event reporting that web-1 was killed.
+func emitKilling(ctx context.Context, cluster *fakecluster.Cluster) error {
+	event := eventsv1.Event{
+		TypeMeta:            metav1.TypeMeta{APIVersion: "events.k8s.io/v1", Kind: "Event"},
+		ObjectMeta:          metav1.ObjectMeta{Name: "web-1.killing", Namespace: namespace},
+		EventTime:           metav1.NowMicro(),
+		ReportingController: "kubelet",
+		ReportingInstance:   "node-1",
+		Action:              "Killing",
+		Reason:              "Killing",
+		Type:                corev1.EventTypeNormal,
+		Regarding: corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Namespace:  namespace,
+			Name:       "web-1",
+			UID:        "6f1c3c9e-web-1",
+		},
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&event)
+	if err != nil {
+		return err
+	}
+
+	return cluster.Apply(ctx, unstructured.Unstructured{Object: content})
+}
+
+func Test_Events_SinceStart(t *testing.T) {
+	testEnv, cluster := newTestEnv(t, podsPath, eventsPath)
+
+	killing := fakecluster.Step{After: 50 * time.Millisecond, Change: emitKilling}
+
+	assert := events.NewEventAssertion(
+		assertion.WithInterval(10*time.Millisecond),
+		assertion.WithSetup(cluster.Play(killing)),
+	).SinceStart().NoWarnings().WasEmitted("Killing").ExactlyNExist(1)
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, testhelpers.SuccessfulAssert{
+		Name:             "Killing",
+		SuccessfulAssert: func(_ require.TestingT) assertion.Assertion { return assert },
+	})
+}
+
+func Test_Events_SinceStart_SelectsSetupEvents(t *testing.T) {
+	testEnv, cluster := newTestEnv(t, podsPath, eventsPath)
+
+	// The Event is emitted synchronously by a setup step, which then waits for the next second so that the Event would
+	// not be selected if the start time were recorded after the step.
+	setup := func(ctx context.Context, t *testing.T, _ *envconf.Config) context.Context {
+		require.NoError(t, emitKilling(ctx, cluster))
+		time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+
+		return ctx
+	}
+
+	assert := events.NewEventAssertion(
+		assertion.WithInterval(10*time.Millisecond),
+		assertion.WithSetup(setup),
+	).SinceStart().WasEmitted("Killing").ExactlyNExist(1)
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, testhelpers.SuccessfulAssert{
+		Name:             "Killing",
+		SuccessfulAssert: func(_ require.TestingT) assertion.Assertion { return assert },
+	})
+}
@@ -0,0 +1,202 @@
+package fakecluster
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+)
+
+type (
+	// dynamicClient is a dynamic client for a Cluster. The fake dynamic client ignores field selectors and only applies
+	// label selectors when listing, so dynamicClient selects resources itself in the same way as the API server.
+	dynamicClient struct {
+		dynamic.Interface
+
+		cluster *Cluster
+	}
+
+	// resourceClient selects the listed and watched resources of a single resource type.
+	resourceClient struct {
+		dynamic.ResourceInterface
+
+		cluster   *Cluster
+		resource  schema.GroupVersionResource
+		namespace string
+	}
+
+	// namespaceableResourceClient is a resourceClient for all namespaces that can be scoped to a single namespace.
+	namespaceableResourceClient struct {
+		resourceClient
+
+		namespaceable dynamic.NamespaceableResourceInterface
+	}
+
+	// restMapper maps kinds to resources using the current mapper of a Cluster.
+	restMapper struct {
+		cluster *Cluster
+	}
+)
+
+//nolint:ireturn
+func (c dynamicClient) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	namespaceable := c.cluster.client.Resource(resource)
+
+	return namespaceableResourceClient{
+		resourceClient: resourceClient{
+			ResourceInterface: namespaceable,
+			cluster:           c.cluster,
+			resource:          resource,
+			namespace:         "",
+		},
+		namespaceable: namespaceable,
+	}
+}
+
+//nolint:ireturn
+func (c namespaceableResourceClient) Namespace(namespace string) dynamic.ResourceInterface {
+	return resourceClient{
+		ResourceInterface: c.namespaceable.Namespace(namespace),
+		cluster:           c.cluster,
+		resource:          c.resource,
+		namespace:         namespace,
+	}
+}
+
+func (c resourceClient) List(_ context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	labelSelector, fieldSelector, err := selectors(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := c.cluster.list(c.resource, c.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := &unstructured.UnstructuredList{Object: list.Object}
+
+	for _, item := range list.Items {
+		matches, err := manifests.Matches(item, labelSelector, fieldSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		if matches {
+			selected.Items = append(selected.Items, item)
+		}
+	}
+
+	return selected, nil
+}
+
+// Watch watches the selected resources. As with the API server, a resource that is modified so that it is no longer
+// selected is reported as deleted.
+//
+//nolint:ireturn
+func (c resourceClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	labelSelector, fieldSelector, err := selectors(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := c.ResourceInterface.Watch(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return watch.Filter(watcher, func(event watch.Event) (watch.Event, bool) {
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			return event, true
+		}
+
+		matches, err := manifests.Matches(*obj, labelSelector, fieldSelector)
+		if err != nil || matches {
+			return event, true
+		}
+
+		if event.Type == watch.Modified {
+			return watch.Event{Type: watch.Deleted, Object: obj}, true
+		}
+
+		return event, false
+	}), nil
+}
+
+func selectors(opts metav1.ListOptions) (labels.Selector, fields.Selector, error) {
+	labelSelector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fieldSelector, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return labelSelector, fieldSelector, nil
+}
+
+func (m restMapper) KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	m.cluster.mu.RLock()
+	defer m.cluster.mu.RUnlock()
+
+	return m.cluster.mapper.KindFor(resource)
+}
+
+func (m restMapper) KindsFor(resource schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	m.cluster.mu.RLock()
+	defer m.cluster.mu.RUnlock()
+
+	return m.cluster.mapper.KindsFor(resource)
+}
+
+func (m restMapper) ResourceFor(input schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	m.cluster.mu.RLock()
+	defer m.cluster.mu.RUnlock()
+
+	return m.cluster.mapper.ResourceFor(input)
+}
+
+func (m restMapper) ResourcesFor(input schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	m.cluster.mu.RLock()
+	defer m.cluster.mu.RUnlock()
+
+	return m.cluster.mapper.ResourcesFor(input)
+}
+
+func (m restMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	m.cluster.mu.RLock()
+	defer m.cluster.mu.RUnlock()
+
+	return m.cluster.mapper.RESTMapping(gk, versions...)
+}
+
+func (m restMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	m.cluster.mu.RLock()
+	defer m.cluster.mu.RUnlock()
+
+	return m.cluster.mapper.RESTMappings(gk, versions...)
+}
+
+func (m restMapper) ResourceSingularizer(resource string) (string, error) {
+	m.cluster.mu.RLock()
+	defer m.cluster.mu.RUnlock()
+
+	return m.cluster.mapper.ResourceSingularizer(resource)
+}
+
+// Reset rebuilds the mapping from the CustomResourceDefinitions applied to the Cluster. Errors are ignored as the
+// previous mapping is kept.
+func (m restMapper) Reset() {
+	_ = m.cluster.Reset()
+}
@@ -0,0 +1,345 @@
+// fakecluster provides an in-memory cluster backed by a fake dynamic client so that assertions, including custom
+// ones, can be unit tested in milliseconds without Docker or a kind cluster. The cluster is seeded from objects (e.g.
+// YAML fixtures) and its objects can be changed over time with a script to simulate controllers (e.g. a Deployment
+// becoming available after a few seconds).
+package fakecluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/clients"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+)
+
+// defaultNamespace is the namespace of namespaced objects that do not set one, as with kubectl.
+const defaultNamespace = "default"
+
+// Cluster is an in-memory cluster. Assertions evaluated with the envconf.Config returned by Config list and watch
+// the objects of the Cluster instead of a real cluster.
+//
+// The Cluster serves every built-in kind. Custom resources are served once the CustomResourceDefinition that defines
+// them has been applied, as with a real cluster. Unlike a real cluster, no controllers run, so the status of objects
+// only changes when it is set (e.g. by SetStatus or a scripted Step).
+type Cluster struct {
+	cfg    *envconf.Config
+	client *dynamicfake.FakeDynamicClient
+
+	// mu guards scheme and mapper, which change when CustomResourceDefinitions are applied, as well as logs, exec and
+	// proxies.
+	mu      sync.RWMutex
+	scheme  *runtime.Scheme
+	mapper  meta.RESTMapper
+	logs    map[logKey][]byte
+	exec    ExecFunc
+	proxies map[proxyKey]http.Handler
+
+	done     chan struct{}
+	running  sync.WaitGroup
+	errsMu   sync.Mutex
+	errs     []error
+	closeOne sync.Once
+}
+
+// errUnexpectedList is returned if the object tracker does not return an unstructured list.
+var errUnexpectedList = errors.New("unexpected list type")
+
+// New creates a Cluster seeded with the supplied objects. Objects are applied in order, so the
+// CustomResourceDefinitions of custom resources must precede them.
+func New(objs ...unstructured.Unstructured) (*Cluster, error) {
+	scheme := runtime.NewScheme()
+
+	for gvk := range builtinScheme().AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Kind, "List") {
+			registerKind(scheme, gvk)
+		}
+	}
+
+	cluster := &Cluster{
+		cfg:    envconf.NewWithKubeConfig(envconf.RandomName("fakecluster", 24)),
+		client: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, nil),
+		scheme: scheme,
+		done:   make(chan struct{}),
+	}
+
+	if err := cluster.Reset(); err != nil {
+		return nil, err
+	}
+
+	clients.Register(cluster.cfg, &clients.Clients{
+		RESTConfig: nil,
+		Dynamic:    dynamicClient{cluster: cluster},
+		Mapper:     restMapper{cluster: cluster},
+		Logs:       podClient{cluster: cluster},
+		Exec:       podClient{cluster: cluster},
+		Proxy:      proxyClient{cluster: cluster},
+	})
+
+	if err := cluster.Apply(context.Background(), objs...); err != nil {
+		_ = cluster.Close()
+
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// NewFromFiles creates a Cluster seeded with the objects in the supplied YAML or JSON files.
+func NewFromFiles(paths ...string) (*Cluster, error) {
+	objs, err := manifests.ReadFiles(paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(objs...)
+}
+
+// Config returns the envconf.Config used to evaluate assertions against the Cluster (e.g. with env.NewWithConfig).
+func (c *Cluster) Config() *envconf.Config {
+	return c.cfg
+}
+
+// Close stops any scripted changes that have not been made yet and returns the errors of those that failed. The
+// envconf.Config of the Cluster must not be used once it is closed.
+func (c *Cluster) Close() error {
+	c.closeOne.Do(func() {
+		close(c.done)
+	})
+
+	err := c.Wait()
+
+	clients.Forget(c.cfg)
+
+	return err
+}
+
+// Apply creates the supplied objects, or replaces them if they already exist.
+func (c *Cluster) Apply(ctx context.Context, objs ...unstructured.Unstructured) error {
+	for _, obj := range objs {
+		client, err := c.resourceClient(&obj)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Create(ctx, &obj, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			_, err = client.Update(ctx, &obj, metav1.UpdateOptions{})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if obj.GroupVersionKind().GroupKind() == apiextensionsv1.Kind("CustomResourceDefinition") {
+			if err := c.Reset(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes the supplied objects. Only the kind, namespace and name of the objects are used.
+func (c *Cluster) Delete(ctx context.Context, objs ...unstructured.Unstructured) error {
+	for _, obj := range objs {
+		client, err := c.resourceClient(&obj)
+		if err != nil {
+			return err
+		}
+
+		if err := client.Delete(ctx, obj.GetName(), metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetStatus replaces the status of the object with the same kind, namespace and name as the supplied object. The
+// status may be a typed status (e.g. appsv1.DeploymentStatus) or a map (e.g. read from a YAML fixture).
+func (c *Cluster) SetStatus(ctx context.Context, obj unstructured.Unstructured, status any) error {
+	client, err := c.resourceClient(&obj)
+	if err != nil {
+		return err
+	}
+
+	current, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	var content map[string]any
+	if err := json.Unmarshal(encoded, &content); err != nil {
+		return err
+	}
+
+	current.Object["status"] = content
+
+	_, err = client.Update(ctx, current, metav1.UpdateOptions{})
+
+	return err
+}
+
+// Reset rebuilds the mapping of kinds to resources from the built-in kinds and the applied
+// CustomResourceDefinitions, in the same way as discovery information is refreshed when using a real cluster.
+func (c *Cluster) Reset() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	listed, err := c.client.Tracker().List(
+		apiextensionsv1.SchemeGroupVersion.WithResource("customresourcedefinitions"),
+		apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition"),
+		"",
+	)
+	if err != nil {
+		return err
+	}
+
+	list, ok := listed.(*unstructured.UnstructuredList)
+	if !ok {
+		return errUnexpectedList
+	}
+
+	custom := meta.NewDefaultRESTMapper(nil)
+
+	for _, item := range list.Items {
+		var crd apiextensionsv1.CustomResourceDefinition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &crd); err != nil {
+			return err
+		}
+
+		scope := meta.RESTScopeNamespace
+		if crd.Spec.Scope == apiextensionsv1.ClusterScoped {
+			scope = meta.RESTScopeRoot
+		}
+
+		for _, version := range crd.Spec.Versions {
+			if !version.Served {
+				continue
+			}
+
+			gv := schema.GroupVersion{Group: crd.Spec.Group, Version: version.Name}
+			gvk := gv.WithKind(crd.Spec.Names.Kind)
+
+			singular := crd.Spec.Names.Singular
+			if singular == "" {
+				singular = strings.ToLower(crd.Spec.Names.Kind)
+			}
+
+			registerKind(c.scheme, gvk)
+			custom.AddSpecific(gvk, gv.WithResource(crd.Spec.Names.Plural), gv.WithResource(singular), scope)
+		}
+	}
+
+	c.mapper = meta.MultiRESTMapper{testrestmapper.TestOnlyStaticRESTMapper(builtinScheme()), custom}
+
+	return nil
+}
+
+// Wait waits for the scripted changes to be made and returns the errors of those that failed since Wait was last
+// called.
+func (c *Cluster) Wait() error {
+	c.running.Wait()
+
+	c.errsMu.Lock()
+	defer c.errsMu.Unlock()
+
+	err := errors.Join(c.errs...)
+	c.errs = nil
+
+	return err
+}
+
+// list lists the objects of the resource in the namespace, or in all namespaces if it is empty. Unlike the fake
+// dynamic client, the kind of the resource does not need to be known when the Cluster is created.
+func (c *Cluster) list(resource schema.GroupVersionResource, namespace string) (*unstructured.UnstructuredList, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	gvk, err := c.mapper.KindFor(resource)
+	if err != nil {
+		return nil, apierrors.NewNotFound(resource.GroupResource(), "")
+	}
+
+	listed, err := c.client.Tracker().List(resource, gvk, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := listed.(*unstructured.UnstructuredList)
+	if !ok {
+		return nil, errUnexpectedList
+	}
+
+	return list, nil
+}
+
+// resourceClient returns the client for the resource of the supplied object.
+//
+//nolint:ireturn
+func (c *Cluster) resourceClient(obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	gvk := obj.GroupVersionKind()
+
+	c.mu.RLock()
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	c.mu.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		return c.client.Resource(mapping.Resource), nil
+	}
+
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(defaultNamespace)
+	}
+
+	return c.client.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+}
+
+// builtinScheme returns a scheme containing every built-in kind.
+func builtinScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+
+	return scheme
+}
+
+// registerKind registers the kind and its list with the scheme as unstructured types.
+func registerKind(scheme *runtime.Scheme, gvk schema.GroupVersionKind) {
+	if !scheme.Recognizes(gvk) {
+		scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+	}
+
+	listGVK := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+	if !scheme.Recognizes(listGVK) {
+		scheme.AddKnownTypeWithName(listGVK, &unstructured.UnstructuredList{})
+	}
+}
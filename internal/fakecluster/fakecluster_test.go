@@ -0,0 +1,180 @@
+package fakecluster_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/e2e-framework/pkg/env"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/clients"
+	"github.com/DWSR/kubeassert-go/internal/crds"
+	"github.com/DWSR/kubeassert-go/internal/deployments"
+	"github.com/DWSR/kubeassert-go/internal/fakecluster"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+	"github.com/DWSR/kubeassert-go/internal/testhelpers"
+)
+
+const (
+	deploymentPath = "./testdata/deployment.yaml"
+	crdPath        = "./testdata/crd.yaml"
+)
+
+var availableStatus = appsv1.DeploymentStatus{
+	Replicas:          1,
+	AvailableReplicas: 1,
+	Conditions: []appsv1.DeploymentCondition{
+		{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+	},
+}
+
+func newCluster(t *testing.T, paths ...string) *fakecluster.Cluster {
+	t.Helper()
+
+	cluster, err := fakecluster.NewFromFiles(paths...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, cluster.Close()) })
+
+	return cluster
+}
+
+func Test_Cluster_Success(t *testing.T) {
+	objs, err := manifests.ReadFiles(deploymentPath)
+	require.NoError(t, err)
+
+	cluster := newCluster(t, deploymentPath, crdPath)
+	testEnv := env.NewWithConfig(cluster.Config())
+
+	asserts := []testhelpers.SuccessfulAssert{
+		{
+			Name: "Exists_Labels",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceLabels(map[string]string{"app.kubernetes.io/name": "fakecluster_test"}),
+				).ExactlyNExist(2)
+			},
+		},
+		{
+			Name: "Exists_Namespace",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceNamespace("fake"),
+					assertion.WithResourceName("test-deployment"),
+				).ExactlyNExist(1)
+			},
+		},
+		{
+			Name: "IsAvailable_Scripted",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceNamespace("fake"),
+					assertion.WithResourceName("test-deployment"),
+					assertion.WithInterval(10*time.Millisecond),
+					assertion.WithSetup(cluster.Play(fakecluster.SetStatusAfter(50*time.Millisecond, objs[0], availableStatus))),
+				).IsAvailable()
+			},
+		},
+		{
+			Name: "IsAvailable_ScriptedWatch",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithResourceNamespace("other"),
+					assertion.WithWatch(),
+					assertion.WithSetup(cluster.Play(fakecluster.SetStatusAfter(50*time.Millisecond, objs[1], availableStatus))),
+				).IsAvailable()
+			},
+		},
+		{
+			Name: "CRD_Exists",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return crds.NewCRDAssertion(assertion.WithResourceName("widgets.example.com")).Exists()
+			},
+		},
+	}
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, asserts...)
+}
+
+func Test_Cluster_Fail(t *testing.T) {
+	objs, err := manifests.ReadFiles(deploymentPath)
+	require.NoError(t, err)
+
+	cluster := newCluster(t, deploymentPath)
+	testEnv := env.NewWithConfig(cluster.Config())
+
+	asserts := []testhelpers.FailingAssert{
+		{
+			Name: "IsAvailable",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithRequireT(t),
+					assertion.WithResourceNamespace("fake"),
+					assertion.WithTimeout(100*time.Millisecond),
+					assertion.WithInterval(10*time.Millisecond),
+				).IsAvailable()
+			},
+		},
+		{
+			Name: "NoneExist_Deleted",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return deployments.NewDeploymentAssertion(
+					assertion.WithRequireT(t),
+					assertion.WithResourceNamespace("other"),
+					assertion.WithInterval(10*time.Millisecond),
+					assertion.WithSetup(cluster.Play(fakecluster.DeleteAfter(50*time.Millisecond, objs[1]))),
+				).Consistently(200 * time.Millisecond).ExactlyNExist(1)
+			},
+		},
+	}
+
+	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
+}
+
+func Test_Cluster_CustomResources(t *testing.T) {
+	cluster := newCluster(t, crdPath)
+
+	shared, err := clients.ForConfig(cluster.Config())
+	require.NoError(t, err)
+
+	widgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+	list, err := shared.Dynamic.Resource(widgets).Namespace("fake").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+
+	mapping, err := shared.Mapper.RESTMapping(schema.GroupKind{Group: "example.com", Kind: "Widget"}, "v1")
+	require.NoError(t, err)
+	require.Equal(t, widgets, mapping.Resource)
+
+	gadgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "gadgets"}
+
+	_, err = shared.Dynamic.Resource(gadgets).List(context.Background(), metav1.ListOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_Cluster_Wait(t *testing.T) {
+	objs, err := manifests.ReadFiles(deploymentPath)
+	require.NoError(t, err)
+
+	cluster := newCluster(t)
+
+	cluster.Start(context.Background(), fakecluster.ApplyAfter(0, objs...))
+	require.NoError(t, cluster.Wait())
+
+	cluster.Start(context.Background(), fakecluster.SetStatusAfter(0, objs[0], availableStatus))
+	require.NoError(t, cluster.Wait())
+
+	cluster.Start(context.Background(), fakecluster.DeleteAfter(0, objs...))
+	require.NoError(t, cluster.Wait())
+
+	cluster.Start(context.Background(), fakecluster.DeleteAfter(0, objs[0]))
+	require.Error(t, cluster.Wait())
+}
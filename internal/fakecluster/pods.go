@@ -0,0 +1,143 @@
+package fakecluster
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+type (
+	// ExecFunc runs a command in a container of a Pod of a Cluster (see SetExec) and returns its standard output and
+	// error and its exit status. The container is empty when the command runs in the default container of the Pod.
+	ExecFunc func(namespace, name, container string, command []string) (stdout, stderr string, exitCode int)
+
+	// podClient reads the logs set on a Cluster and runs commands with its ExecFunc.
+	podClient struct {
+		cluster *Cluster
+	}
+
+	// logKey identifies the logs of a container.
+	logKey struct {
+		namespace string
+		name      string
+		container string
+		previous  bool
+	}
+)
+
+var (
+	// errExecNotSet is returned when a command is run before an ExecFunc is set.
+	errExecNotSet = errors.New("no ExecFunc is set on the fake cluster")
+	// errNonZeroExit is returned when a command exits with a non-zero status, as by the API server.
+	errNonZeroExit = errors.New("command terminated with exit code")
+)
+
+// SetLogs sets the logs of a container of a Pod, replacing any that were set before. The Pod itself does not need to
+// exist. When logs are read without a container, the logs of the only container with logs set are returned.
+//
+// As with a real cluster, only the last lines are returned when the TailLines option is set. Unlike a real cluster,
+// the SinceTime option is ignored, so lines are never excluded by when they were logged.
+func (c *Cluster) SetLogs(namespace, name, container, logs string) {
+	c.setLogs(logKey{namespace: namespace, name: name, container: container, previous: false}, logs)
+}
+
+// SetPreviousLogs sets the logs of the previous instance of a container of a Pod (e.g. before it crashed and was
+// restarted), which are read with the Previous option.
+func (c *Cluster) SetPreviousLogs(namespace, name, container, logs string) {
+	c.setLogs(logKey{namespace: namespace, name: name, container: container, previous: true}, logs)
+}
+
+func (c *Cluster) setLogs(key logKey, logs string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.logs == nil {
+		c.logs = make(map[logKey][]byte)
+	}
+
+	c.logs[key] = []byte(logs)
+}
+
+func (r podClient) PodLogs(_ context.Context, namespace, name string, opts *corev1.PodLogOptions) ([]byte, error) {
+	r.cluster.mu.RLock()
+	defer r.cluster.mu.RUnlock()
+
+	key := logKey{namespace: namespace, name: name, container: opts.Container, previous: opts.Previous}
+
+	if key.container == "" {
+		var found []logKey
+
+		for candidate := range r.cluster.logs {
+			if candidate.namespace == namespace && candidate.name == name && candidate.previous == opts.Previous {
+				found = append(found, candidate)
+			}
+		}
+
+		if len(found) == 1 {
+			key = found[0]
+		}
+	}
+
+	logs, ok := r.cluster.logs[key]
+	if !ok {
+		return nil, apierrors.NewNotFound(corev1.Resource("pods/log"), name)
+	}
+
+	return tailLines(logs, opts.TailLines), nil
+}
+
+// tailLines returns the last n lines of the logs, or every line if n is nil.
+func tailLines(logs []byte, n *int64) []byte {
+	if n == nil {
+		return logs
+	}
+
+	lines := bytes.SplitAfter(logs, []byte("\n"))
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	if int64(len(lines)) <= *n {
+		return logs
+	}
+
+	return bytes.Join(lines[int64(len(lines))-max(*n, 0):], nil)
+}
+
+// SetExec sets the function that runs the commands executed in the containers of Pods, replacing any that was set
+// before. Commands fail with an error until one is set.
+func (c *Cluster) SetExec(exec ExecFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.exec = exec
+}
+
+func (r podClient) PodExec(
+	_ context.Context,
+	namespace, name string,
+	opts *corev1.PodExecOptions,
+) (stdout, stderr []byte, err error) {
+	r.cluster.mu.RLock()
+	exec := r.cluster.exec
+	r.cluster.mu.RUnlock()
+
+	if exec == nil {
+		return nil, nil, errExecNotSet
+	}
+
+	stdoutStr, stderrStr, exitCode := exec(namespace, name, opts.Container, opts.Command)
+	if exitCode != 0 {
+		err = utilexec.CodeExitError{
+			Err:  fmt.Errorf("%w %d", errNonZeroExit, exitCode),
+			Code: exitCode,
+		}
+	}
+
+	return []byte(stdoutStr), []byte(stderrStr), err
+}
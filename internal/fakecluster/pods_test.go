@@ -0,0 +1,380 @@
+package fakecluster_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilexec "k8s.io/client-go/util/exec"
+	"sigs.k8s.io/e2e-framework/pkg/env"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/clients"
+	"github.com/DWSR/kubeassert-go/internal/fakecluster"
+	"github.com/DWSR/kubeassert-go/internal/pods"
+	"github.com/DWSR/kubeassert-go/internal/testhelpers"
+)
+
+const podsPath = "./testdata/pods.yaml"
+
+func newLogsCluster(t *testing.T) *fakecluster.Cluster {
+	t.Helper()
+
+	cluster := newCluster(t, podsPath)
+	cluster.SetLogs("logs", "web-1", "web", "listening on :8080\n")
+	cluster.SetLogs("logs", "web-1", "proxy", "all clusters initialized\n")
+	cluster.SetLogs("logs", "web-2", "web", "listening on :8080\nfailed to load config\n")
+	cluster.SetLogs("logs", "web-2", "proxy", "all clusters initialized\n")
+	cluster.SetPreviousLogs("logs", "web-2", "web", "panic: out of memory\n")
+
+	return cluster
+}
+
+func webPods(opts ...assertion.Option) pods.PodAssertion {
+	return pods.NewPodAssertion(append([]assertion.Option{
+		assertion.WithResourceNamespace("logs"),
+		assertion.WithResourceLabels(map[string]string{"app": "web"}),
+	}, opts...)...)
+}
+
+func Test_Cluster_PodLogs(t *testing.T) {
+	cluster := newLogsCluster(t)
+
+	shared, err := clients.ForConfig(cluster.Config())
+	require.NoError(t, err)
+
+	logs, err := shared.Logs.PodLogs(context.Background(), "logs", "web-2", &corev1.PodLogOptions{
+		Container: "web",
+		Previous:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "panic: out of memory\n", string(logs))
+
+	tailLines := int64(1)
+
+	logs, err = shared.Logs.PodLogs(context.Background(), "logs", "web-2", &corev1.PodLogOptions{
+		Container: "web",
+		TailLines: &tailLines,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "failed to load config\n", string(logs))
+
+	_, err = shared.Logs.PodLogs(context.Background(), "logs", "web-1", &corev1.PodLogOptions{Container: "db"})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_Cluster_PodLogs_Success(t *testing.T) {
+	testEnv := env.NewWithConfig(newLogsCluster(t).Config())
+
+	asserts := []testhelpers.SuccessfulAssert{
+		{
+			Name: "LogsContain",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods(assertion.WithResourceName("web-2")).LogsContain("failed to load config")
+			},
+		},
+		{
+			Name: "ExactlyNLogsContain",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods().
+					ExactlyNLogsContain(2, `listening on :\d+`, pods.InContainer("web")).
+					LogsDoNotContain("listening", pods.InContainer("proxy"))
+			},
+		},
+		{
+			Name: "AtLeastNLogsContain",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods().AtLeastNLogsContain(2, "initialized", pods.SinceTime(time.Now().Add(-time.Hour)))
+			},
+		},
+		{
+			Name: "LogsContain_Previous",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods(assertion.WithResourceName("web-2")).
+					LogsContain("panic:", pods.InContainer("web"), pods.Previous())
+			},
+		},
+		{
+			Name: "LogsDoNotContain",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods().LogsDoNotContain("panic:")
+			},
+		},
+		{
+			Name: "ExactlyNLogsDoNotContain",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods(assertion.WithResourceName("web-1")).
+					ExactlyNLogsDoNotContain(1, "failed", pods.InContainer("web"))
+			},
+		},
+		{
+			Name: "AtLeastNLogsDoNotContain",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods().AtLeastNLogsDoNotContain(2, "panic:", pods.AllLines())
+			},
+		},
+		{
+			Name: "LogsDoNotContain_TailLines",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods(assertion.WithResourceName("web-2")).
+					LogsDoNotContain("listening", pods.InContainer("web"), pods.TailLines(1))
+			},
+		},
+	}
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, asserts...)
+}
+
+func Test_Cluster_PodLogs_Fail(t *testing.T) {
+	testEnv := env.NewWithConfig(newLogsCluster(t).Config())
+
+	options := func(t require.TestingT) []assertion.Option {
+		return []assertion.Option{
+			assertion.WithRequireT(t),
+			assertion.WithTimeout(100 * time.Millisecond),
+			assertion.WithInterval(10 * time.Millisecond),
+		}
+	}
+
+	asserts := []testhelpers.FailingAssert{
+		{
+			Name: "LogsContain",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(append(options(t), assertion.WithResourceName("web-1"))...).LogsContain("failed")
+			},
+		},
+		{
+			Name: "LogsDoNotContain",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(options(t)...).LogsDoNotContain("failed to load config")
+			},
+		},
+		{
+			Name: "AtLeastNLogsDoNotContain",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(options(t)...).AtLeastNLogsDoNotContain(2, "failed to load config")
+			},
+		},
+		{
+			Name: "LogsContain_TailLines",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(append(options(t), assertion.WithResourceName("web-2"))...).
+					LogsContain("listening", pods.InContainer("web"), pods.TailLines(1))
+			},
+		},
+		{
+			Name: "LogsDoNotContain_MissingLogs",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(options(t)...).LogsDoNotContain("panic:", pods.InContainer("db"))
+			},
+		},
+		{
+			Name: "LogsContain_InvalidPattern",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(options(t)...).LogsContain("(")
+			},
+		},
+	}
+
+	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
+}
+
+func Test_Cluster_PodLogs_DoNotContainReadsEveryLine(t *testing.T) {
+	cluster := newCluster(t, podsPath)
+	cluster.SetLogs("logs", "web-1", "web", "failed to load config\n"+strings.Repeat("retrying\n", pods.DefaultTailLines))
+
+	testEnv := env.NewWithConfig(cluster.Config())
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, testhelpers.SuccessfulAssert{
+		Name: "LogsContain_AllLines",
+		SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+			return webPods(assertion.WithResourceName("web-1")).
+				LogsContain("failed", pods.InContainer("web"), pods.AllLines())
+		},
+	})
+
+	testhelpers.TestFailingAsserts(t, testEnv,
+		testhelpers.FailingAssert{
+			Name: "LogsContain",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(
+					assertion.WithRequireT(t),
+					assertion.WithResourceName("web-1"),
+					assertion.WithTimeout(100*time.Millisecond),
+					assertion.WithInterval(10*time.Millisecond),
+				).LogsContain("failed", pods.InContainer("web"))
+			},
+		},
+		testhelpers.FailingAssert{
+			Name: "LogsDoNotContain",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(
+					assertion.WithRequireT(t),
+					assertion.WithResourceName("web-1"),
+					assertion.WithTimeout(100*time.Millisecond),
+					assertion.WithInterval(10*time.Millisecond),
+				).LogsDoNotContain("failed", pods.InContainer("web"))
+			},
+		},
+	)
+}
+
+func Test_Cluster_PodLogs_ReportsReadErrors(t *testing.T) {
+	cluster := newCluster(t, podsPath)
+	cluster.SetLogs("logs", "web-1", "web", "listening on :8080\n")
+
+	testEnv := env.NewWithConfig(cluster.Config())
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, testhelpers.SuccessfulAssert{
+		Name: "LogsContain",
+		SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+			return webPods(assertion.WithResourceName("web-1")).LogsContain("listening")
+		},
+	})
+
+	mockT := &testhelpers.MockT{}
+
+	testEnv.Test(t, assertion.AsFeature(webPods(
+		assertion.WithRequireT(mockT),
+		assertion.WithResourceName("web-1"),
+		assertion.WithTimeout(100*time.Millisecond),
+		assertion.WithInterval(10*time.Millisecond),
+	).LogsDoNotContain("panic:")))
+
+	require.True(t, mockT.Failed)
+	require.Contains(t, strings.Join(mockT.Errors, "\n"), `error: reading logs of container "proxy"`)
+}
+
+// execConfig is an ExecFunc that prints the configuration of the web container of web-1.
+func execConfig(_, name, container string, command []string) (string, string, int) {
+	switch {
+	case !slices.Equal(command, []string{"cat", "/etc/app/config.yaml"}):
+		return "", "command not found", 127
+	case name != "web-1" || container != "web":
+		return "", "No such file or directory", 1
+	}
+
+	return "listen: 8080\n", "", 0
+}
+
+func Test_Cluster_PodExec(t *testing.T) {
+	cluster := newCluster(t, podsPath)
+
+	shared, err := clients.ForConfig(cluster.Config())
+	require.NoError(t, err)
+
+	opts := &corev1.PodExecOptions{Container: "web", Command: []string{"cat", "/etc/app/config.yaml"}}
+
+	_, _, err = shared.Exec.PodExec(context.Background(), "logs", "web-1", opts)
+	require.Error(t, err)
+
+	cluster.SetExec(execConfig)
+
+	stdout, _, err := shared.Exec.PodExec(context.Background(), "logs", "web-1", opts)
+	require.NoError(t, err)
+	require.Equal(t, "listen: 8080\n", string(stdout))
+
+	_, stderr, err := shared.Exec.PodExec(context.Background(), "logs", "web-2", opts)
+
+	var exitErr utilexec.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	require.Equal(t, 1, exitErr.ExitStatus())
+	require.Equal(t, "No such file or directory", string(stderr))
+}
+
+func Test_Cluster_PodExec_Success(t *testing.T) {
+	cluster := newCluster(t, podsPath)
+	cluster.SetExec(execConfig)
+
+	testEnv := env.NewWithConfig(cluster.Config())
+
+	asserts := []testhelpers.SuccessfulAssert{
+		{
+			Name: "ExecSucceeds",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods(assertion.WithResourceName("web-1")).ExecSucceeds("web", "cat", "/etc/app/config.yaml")
+			},
+		},
+		{
+			Name: "AtLeastNExecSucceed",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods().AtLeastNExecSucceed(1, "web", "cat", "/etc/app/config.yaml")
+			},
+		},
+		{
+			Name: "ExecOutputMatches",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods(assertion.WithResourceName("web-1")).
+					ExecOutputMatches("web", `(?m)^listen: 8080$`, "cat", "/etc/app/config.yaml")
+			},
+		},
+		{
+			Name: "AtLeastNExecOutputsMatch",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods().AtLeastNExecOutputsMatch(1, "web", "8080", "cat", "/etc/app/config.yaml")
+			},
+		},
+	}
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, asserts...)
+}
+
+func Test_Cluster_PodExec_Fail(t *testing.T) {
+	cluster := newCluster(t, podsPath)
+	cluster.SetExec(execConfig)
+
+	testEnv := env.NewWithConfig(cluster.Config())
+
+	options := func(t require.TestingT) []assertion.Option {
+		return []assertion.Option{
+			assertion.WithRequireT(t),
+			assertion.WithTimeout(100 * time.Millisecond),
+			assertion.WithInterval(10 * time.Millisecond),
+		}
+	}
+
+	asserts := []testhelpers.FailingAssert{
+		{
+			Name: "ExecSucceeds",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(append(options(t), assertion.WithResourceName("web-2"))...).
+					ExecSucceeds("web", "cat", "/etc/app/config.yaml")
+			},
+		},
+		{
+			Name: "ExecOutputMatches",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(append(options(t), assertion.WithResourceName("web-1"))...).
+					ExecOutputMatches("web", "9090", "cat", "/etc/app/config.yaml")
+			},
+		},
+		{
+			Name: "ExecSucceeds_EmptyCommand",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return webPods(append(options(t), assertion.WithResourceName("web-1"))...).ExecSucceeds("web")
+			},
+		},
+	}
+
+	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
+}
+
+func Test_Cluster_PodExec_ReportsErrors(t *testing.T) {
+	testEnv := env.NewWithConfig(newCluster(t, podsPath).Config())
+	mockT := &testhelpers.MockT{}
+
+	testEnv.Test(t, assertion.AsFeature(webPods(
+		assertion.WithRequireT(mockT),
+		assertion.WithResourceName("web-1"),
+		assertion.WithTimeout(100*time.Millisecond),
+		assertion.WithInterval(10*time.Millisecond),
+	).ExecSucceeds("web", "cat", "/etc/app/config.yaml")))
+
+	require.True(t, mockT.Failed)
+	require.Contains(t, strings.Join(mockT.Errors, "\n"), `error: running "cat"`)
+}
@@ -0,0 +1,57 @@
+package fakecluster
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type (
+	// proxyClient sends requests to the handlers set on a Cluster.
+	proxyClient struct {
+		cluster *Cluster
+	}
+
+	// proxyKey identifies the port of a Pod or Service that requests are proxied to.
+	proxyKey struct {
+		resource  string
+		namespace string
+		name      string
+		port      string
+	}
+)
+
+// SetProxy sets the handler of the requests proxied to the port (a name or number, or empty for the default port) of
+// a Pod or Service, identified by its resource (i.e. "pods" or "services"), replacing any that was set before. The
+// handler receives requests for the path that was proxied. Requests to ports without a handler fail with NotFound.
+func (c *Cluster) SetProxy(resource, namespace, name, port string, handler http.Handler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.proxies == nil {
+		c.proxies = make(map[proxyKey]http.Handler)
+	}
+
+	c.proxies[proxyKey{resource: resource, namespace: namespace, name: name, port: port}] = handler
+}
+
+func (p proxyClient) ProxyGet(
+	ctx context.Context,
+	resource, namespace, name, port, path string,
+) (statusCode int, body []byte, err error) {
+	p.cluster.mu.RLock()
+	handler, ok := p.cluster.proxies[proxyKey{resource: resource, namespace: namespace, name: name, port: port}]
+	p.cluster.mu.RUnlock()
+
+	if !ok {
+		return 0, nil, apierrors.NewNotFound(corev1.Resource(resource+"/proxy"), name)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequestWithContext(ctx, http.MethodGet, path, nil))
+
+	return recorder.Code, recorder.Body.Bytes(), nil
+}
@@ -0,0 +1,156 @@
+package fakecluster_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/e2e-framework/pkg/env"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/clients"
+	"github.com/DWSR/kubeassert-go/internal/fakecluster"
+	"github.com/DWSR/kubeassert-go/internal/services"
+	"github.com/DWSR/kubeassert-go/internal/testhelpers"
+)
+
+const servicesPath = "./testdata/services.yaml"
+
+// healthHandler serves a healthy /healthz endpoint and a /readyz endpoint that is not ready.
+func healthHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"status": "UP"}`))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	return mux
+}
+
+func newProxyCluster(t *testing.T) *fakecluster.Cluster {
+	t.Helper()
+
+	cluster := newCluster(t, podsPath, servicesPath)
+	cluster.SetProxy("services", "logs", "web", "http", healthHandler())
+	cluster.SetProxy("pods", "logs", "web-1", "8080", healthHandler())
+	cluster.SetProxy("pods", "logs", "web-2", "8080", healthHandler())
+
+	return cluster
+}
+
+func Test_Cluster_ProxyGet(t *testing.T) {
+	cluster := newProxyCluster(t)
+
+	shared, err := clients.ForConfig(cluster.Config())
+	require.NoError(t, err)
+
+	statusCode, body, err := shared.Proxy.ProxyGet(context.Background(), "services", "logs", "web", "http", "/healthz")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.JSONEq(t, `{"status": "UP"}`, string(body))
+
+	statusCode, _, err = shared.Proxy.ProxyGet(context.Background(), "pods", "logs", "web-1", "8080", "/readyz")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, statusCode)
+
+	_, _, err = shared.Proxy.ProxyGet(context.Background(), "services", "logs", "web", "9090", "/healthz")
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_Cluster_ProxyGet_Success(t *testing.T) {
+	testEnv := env.NewWithConfig(newProxyCluster(t).Config())
+
+	asserts := []testhelpers.SuccessfulAssert{
+		{
+			Name: "Service_RespondsHTTP",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return services.NewServiceAssertion(
+					assertion.WithResourceNamespace("logs"),
+					assertion.WithResourceName("web"),
+				).
+					RespondsHTTP("/healthz", "http", http.StatusOK, `"status":\s*"UP"`).
+					RespondsHTTP("/readyz", "http", http.StatusServiceUnavailable, "")
+			},
+		},
+		{
+			Name: "Pod_ExactlyNRespondHTTP",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods().ExactlyNRespondHTTP(2, "/healthz", "8080", http.StatusOK, "UP")
+			},
+		},
+		{
+			Name: "Pod_AtLeastNRespondHTTP",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return webPods().AtLeastNRespondHTTP(1, "/healthz", "8080", http.StatusOK, "")
+			},
+		},
+	}
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, asserts...)
+}
+
+func Test_Cluster_ProxyGet_Fail(t *testing.T) {
+	testEnv := env.NewWithConfig(newProxyCluster(t).Config())
+
+	options := func(t require.TestingT) []assertion.Option {
+		return []assertion.Option{
+			assertion.WithRequireT(t),
+			assertion.WithTimeout(100 * time.Millisecond),
+			assertion.WithInterval(10 * time.Millisecond),
+			assertion.WithResourceNamespace("logs"),
+			assertion.WithResourceName("web"),
+		}
+	}
+
+	asserts := []testhelpers.FailingAssert{
+		{
+			Name: "RespondsHTTP_Status",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return services.NewServiceAssertion(options(t)...).RespondsHTTP("/readyz", "http", http.StatusOK, "")
+			},
+		},
+		{
+			Name: "RespondsHTTP_Body",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return services.NewServiceAssertion(options(t)...).RespondsHTTP("/healthz", "http", http.StatusOK, "DOWN")
+			},
+		},
+		{
+			Name: "RespondsHTTP_Port",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return services.NewServiceAssertion(options(t)...).RespondsHTTP("/healthz", "9090", http.StatusOK, "")
+			},
+		},
+		{
+			Name: "RespondsHTTP_InvalidPattern",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return services.NewServiceAssertion(options(t)...).RespondsHTTP("/healthz", "http", http.StatusOK, "(")
+			},
+		},
+	}
+
+	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
+}
+
+func Test_Cluster_ProxyGet_ReportsErrors(t *testing.T) {
+	testEnv := env.NewWithConfig(newProxyCluster(t).Config())
+	mockT := &testhelpers.MockT{}
+
+	testEnv.Test(t, assertion.AsFeature(services.NewServiceAssertion(
+		assertion.WithRequireT(mockT),
+		assertion.WithTimeout(100*time.Millisecond),
+		assertion.WithInterval(10*time.Millisecond),
+		assertion.WithResourceNamespace("logs"),
+		assertion.WithResourceName("web"),
+	).RespondsHTTP("/healthz", "9090", http.StatusOK, "")))
+
+	require.True(t, mockT.Failed)
+	require.Contains(t, strings.Join(mockT.Errors, "\n"), `error: requesting /healthz on port "9090"`)
+}
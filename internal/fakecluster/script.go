@@ -0,0 +1,89 @@
+package fakecluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+	e2etypes "sigs.k8s.io/e2e-framework/pkg/types"
+)
+
+// Step is a change made to a Cluster once a delay has elapsed since the script containing it was started.
+type Step struct {
+	// After is the delay, relative to the start of the script, after which the change is made.
+	After time.Duration
+	// Change makes the change to the Cluster.
+	Change func(ctx context.Context, cluster *Cluster) error
+}
+
+// ApplyAfter returns a Step that applies the supplied objects after the delay.
+func ApplyAfter(delay time.Duration, objs ...unstructured.Unstructured) Step {
+	return Step{
+		After: delay,
+		Change: func(ctx context.Context, cluster *Cluster) error {
+			return cluster.Apply(ctx, objs...)
+		},
+	}
+}
+
+// DeleteAfter returns a Step that deletes the supplied objects after the delay.
+func DeleteAfter(delay time.Duration, objs ...unstructured.Unstructured) Step {
+	return Step{
+		After: delay,
+		Change: func(ctx context.Context, cluster *Cluster) error {
+			return cluster.Delete(ctx, objs...)
+		},
+	}
+}
+
+// SetStatusAfter returns a Step that replaces the status of the supplied object after the delay (e.g. to simulate a
+// Deployment becoming available).
+func SetStatusAfter(delay time.Duration, obj unstructured.Unstructured, status any) Step {
+	return Step{
+		After: delay,
+		Change: func(ctx context.Context, cluster *Cluster) error {
+			return cluster.SetStatus(ctx, obj, status)
+		},
+	}
+}
+
+// Start starts a script that makes each change in the background once its delay has elapsed. Changes are not made
+// once ctx is done or the Cluster is closed. Errors are returned by Wait and Close.
+func (c *Cluster) Start(ctx context.Context, steps ...Step) {
+	for _, step := range steps {
+		c.running.Add(1)
+
+		go func() {
+			defer c.running.Done()
+
+			timer := time.NewTimer(step.After)
+			defer timer.Stop()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.done:
+				return
+			case <-timer.C:
+			}
+
+			if err := step.Change(ctx, c); err != nil {
+				c.errsMu.Lock()
+				c.errs = append(c.errs, err)
+				c.errsMu.Unlock()
+			}
+		}()
+	}
+}
+
+// Play returns a StepFunc that starts a script (see Start) when the step is run, so that the delays of the script are
+// relative to that point of the Feature (e.g. after the resources are created).
+func (c *Cluster) Play(steps ...Step) e2etypes.StepFunc {
+	return func(ctx context.Context, _ *testing.T, _ *envconf.Config) context.Context {
+		c.Start(context.WithoutCancel(ctx), steps...)
+
+		return ctx
+	}
+}
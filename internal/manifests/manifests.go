@@ -0,0 +1,128 @@
+// manifests reads Kubernetes objects from YAML or JSON files and renders kustomizations so that they can be applied to
+// a cluster or evaluated by assertions without one.
+package manifests
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/kustomize/api/krusty"
+	kusttypes "sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+const decodeBufferSize = 4096
+
+// RenderKustomization renders the kustomization in the supplied directory. Helm charts are inflated using the helm
+// binary and remote resources may be loaded.
+func RenderKustomization(kustDir string) ([]unstructured.Unstructured, error) {
+	opts := krusty.MakeDefaultOptions()
+	opts.PluginConfig.HelmConfig = kusttypes.HelmConfig{
+		Enabled: true,
+		Command: "helm",
+		Debug:   false,
+	}
+	opts.PluginConfig.FnpLoadingOptions.Network = true
+	opts.LoadRestrictions = kusttypes.LoadRestrictionsNone
+	opts.Reorder = krusty.ReorderOptionLegacy
+
+	resMap, err := krusty.MakeKustomizer(opts).Run(filesys.MakeFsOnDisk(), kustDir)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := make([]unstructured.Unstructured, 0, resMap.Size())
+
+	for _, res := range resMap.Resources() {
+		// Resources are decoded with Read, rather than with res.Map, so that whole numbers are int64 as required by
+		// unstructured.Unstructured (e.g. for DeepCopy).
+		raw, err := res.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+
+		read, err := Read(bytes.NewReader(raw))
+		if err != nil {
+			return nil, err
+		}
+
+		objs = append(objs, read...)
+	}
+
+	return objs, nil
+}
+
+// ReadFiles reads the objects in the supplied YAML or JSON files. Files may contain multiple documents separated by
+// "---" and the items of lists (e.g. a v1 List) are returned as individual objects.
+func ReadFiles(paths ...string) ([]unstructured.Unstructured, error) {
+	var objs []unstructured.Unstructured
+
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		read, err := Read(file)
+
+		_ = file.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		objs = append(objs, read...)
+	}
+
+	return objs, nil
+}
+
+// Read reads the objects in a YAML or JSON stream. As with objects read from the API server, whole numbers are
+// decoded as int64 rather than float64.
+func Read(reader io.Reader) ([]unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(reader, decodeBufferSize)
+
+	var objs []unstructured.Unstructured
+
+	for {
+		var raw json.RawMessage
+
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip empty documents (e.g. a trailing "---").
+		if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+			continue
+		}
+
+		var obj unstructured.Unstructured
+		if err := obj.UnmarshalJSON(raw); err != nil {
+			return nil, err
+		}
+
+		if !obj.IsList() {
+			objs = append(objs, obj)
+
+			continue
+		}
+
+		list, err := obj.ToList()
+		if err != nil {
+			return nil, err
+		}
+
+		objs = append(objs, list.Items...)
+	}
+}
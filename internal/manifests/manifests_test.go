@@ -0,0 +1,43 @@
+package manifests_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+)
+
+func TestReadFiles(t *testing.T) {
+	objs, err := manifests.ReadFiles("./testdata/manifests.yaml")
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+
+	require.Equal(t, "Namespace", objs[0].GetKind())
+	require.Equal(t, "first", objs[1].GetName())
+	require.Equal(t, "second", objs[2].GetName())
+	require.Equal(t, "test", objs[2].GetNamespace())
+}
+
+func TestReadFiles_Missing(t *testing.T) {
+	_, err := manifests.ReadFiles("./testdata/missing.yaml")
+	require.Error(t, err)
+}
+
+func TestRenderKustomization(t *testing.T) {
+	objs, err := manifests.RenderKustomization("./testdata/kustomization")
+	require.NoError(t, err)
+	require.Len(t, objs, 2)
+
+	require.Equal(t, "ConfigMap", objs[0].GetKind())
+	require.Equal(t, "rendered", objs[0].GetNamespace())
+
+	// Whole numbers must be int64, as with objects read from the API server, or DeepCopy panics.
+	deploy := objs[1].DeepCopy()
+
+	replicas, found, err := unstructured.NestedInt64(deploy.Object, "spec", "replicas")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(2), replicas)
+}
@@ -0,0 +1,50 @@
+package manifests
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Matches returns true if the object matches the label and field selectors, mirroring how the API server selects
+// resources. Unlike the API server, any field of the object can be used in the field selector.
+func Matches(
+	obj unstructured.Unstructured,
+	labelSelector labels.Selector,
+	fieldSelector fields.Selector,
+) (bool, error) {
+	if !labelSelector.Matches(labels.Set(obj.GetLabels())) {
+		return false, nil
+	}
+
+	objFields, err := selectedFields(obj, fieldSelector)
+	if err != nil {
+		return false, err
+	}
+
+	return fieldSelector.Matches(objFields), nil
+}
+
+// selectedFields returns the values of the fields of the object used by the field selector. Fields that are not set
+// (e.g. metadata.namespace of a cluster scoped object) are empty.
+func selectedFields(obj unstructured.Unstructured, selector fields.Selector) (fields.Set, error) {
+	set := fields.Set{}
+
+	for _, req := range selector.Requirements() {
+		value, found, err := unstructured.NestedFieldNoCopy(obj.Object, strings.Split(req.Field, ".")...)
+		if err != nil {
+			return nil, err
+		}
+
+		if found {
+			set[req.Field] = fmt.Sprint(value)
+		} else {
+			set[req.Field] = ""
+		}
+	}
+
+	return set, nil
+}
@@ -0,0 +1,82 @@
+// metrics provides Prometheus metrics about the evaluation of assertions (e.g. how long it took for Deployments to
+// become available). The metrics are always updated but are only exposed once registered with Register.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "kubeassert"
+
+	// ResultPassed is the value of the result label of a check that was satisfied.
+	ResultPassed = "passed"
+	// ResultFailed is the value of the result label of a check that was not satisfied.
+	ResultFailed = "failed"
+	// ResultSuccess is the value of the result label of a successful API request.
+	ResultSuccess = "success"
+	// ResultError is the value of the result label of a failed API request.
+	ResultError = "error"
+)
+
+//nolint:gochecknoglobals,mnd
+var (
+	// CheckDuration is the time taken for a check to be satisfied or to fail, by kind, check and result.
+	CheckDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "check_duration_seconds",
+			Help:      "Time taken for a check to be satisfied or to fail.",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14),
+		},
+		[]string{"kind", "check", "result"},
+	)
+
+	// CheckAttempts is the number of times a check was evaluated before it was satisfied or failed, by kind and check.
+	CheckAttempts = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "check_attempts",
+			Help:      "Number of times a check was evaluated before it was satisfied or failed.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		},
+		[]string{"kind", "check"},
+	)
+
+	// CheckFailures is the number of checks that were not satisfied, by kind and check.
+	CheckFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "check_failures_total",
+			Help:      "Number of checks that were not satisfied.",
+		},
+		[]string{"kind", "check"},
+	)
+
+	// ListDuration is the latency of listing resources from the API server, by resource and result.
+	ListDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "list_duration_seconds",
+			Help:      "Latency of listing resources from the API server.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"resource", "result"},
+	)
+)
+
+// Collectors returns the collectors of every kubeassert metric.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{CheckDuration, CheckAttempts, CheckFailures, ListDuration}
+}
+
+// Register registers every kubeassert metric with the supplied Registerer (e.g. prometheus.DefaultRegisterer).
+func Register(registerer prometheus.Registerer) error {
+	for _, collector := range Collectors() {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
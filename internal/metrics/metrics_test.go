@@ -0,0 +1,29 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DWSR/kubeassert-go/internal/metrics"
+)
+
+func TestRegister(t *testing.T) {
+	registry := prometheus.NewPedanticRegistry()
+
+	require.NoError(t, metrics.Register(registry))
+
+	metrics.CheckDuration.WithLabelValues("Deployment", "exactlyNAreAvailable", metrics.ResultPassed).Observe(1)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(families))
+	for _, family := range families {
+		names = append(names, family.GetName())
+	}
+
+	require.Contains(t, names, "kubeassert_check_duration_seconds")
+	require.Error(t, metrics.Register(registry), "registering the metrics twice should fail")
+}
@@ -1,52 +1,21 @@
 package namespaces
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/e2e-framework/pkg/features"
 
 	"github.com/DWSR/kubeassert-go/internal/assertion"
-	helpers "github.com/DWSR/kubeassert-go/internal/assertionhelpers"
 )
 
 // NamespaceAssertion is an assertion for Kubernetes Namespaces.
 type NamespaceAssertion struct {
-	assertion.Assertion
+	assertion.ResourceAssertion[corev1.Namespace, NamespaceAssertion]
 }
 
 const (
 	podSecurityEnforceLabelKey = "pod-security.kubernetes.io/enforce"
 )
 
-func (na NamespaceAssertion) clone() NamespaceAssertion {
-	return NamespaceAssertion{
-		Assertion: assertion.Clone(na.Assertion),
-	}
-}
-
-// Exists asserts that exactly one Namespace exists.
-func (na NamespaceAssertion) Exists() NamespaceAssertion {
-	return na.ExactlyNExist(1)
-}
-
-// ExactlyNExist asserts that exactly N Namespaces exist.
-func (na NamespaceAssertion) ExactlyNExist(count int) NamespaceAssertion {
-	stepFn := helpers.AsStepFunc(na, exist(), count, helpers.IntCompareFuncEqualTo, nil)
-
-	res := na.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNExist", stepFn))
-
-	return res
-}
-
-// AtLeastNExist asserts that at least N Namespaces exist.
-func (na NamespaceAssertion) AtLeastNExist(count int) NamespaceAssertion {
-	stepFn := helpers.AsStepFunc(na, exist(), count, helpers.IntCompareFuncGreaterThanOrEqualTo, nil)
-
-	res := na.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNExist", stepFn))
-
-	return res
-}
-
 // IsRestricted asserts that exactly one Namespace uses the default "restricted" pod security standard.
 func (na NamespaceAssertion) IsRestricted() NamespaceAssertion {
 	return na.ExactlyNAreRestricted(1)
@@ -54,42 +23,29 @@ func (na NamespaceAssertion) IsRestricted() NamespaceAssertion {
 
 // ExactlyNAreRestricted asserts that exactly N Namespaces use the default "restricted" pod security standard.
 func (na NamespaceAssertion) ExactlyNAreRestricted(count int) NamespaceAssertion {
-	stepFn := helpers.AsStepFunc(
-		na,
-		areRestricted(),
-		count,
-		helpers.IntCompareFuncNotEqualTo,
-		helpers.IntCompareFuncEqualTo,
-	)
-	res := na.clone()
-	res.SetBuilder(na.GetBuilder().Assess("exactlyNAreRestricted", stepFn))
-
-	return res
+	return na.ExactlyNMatch("exactlyNAreRestricted", count, isRestricted)
 }
 
 // AtLeastNAreRestricted asserts that at least N Namespaces use the default "restricted" pod security standard.
 func (na NamespaceAssertion) AtLeastNAreRestricted(count int) NamespaceAssertion {
-	stepFn := helpers.AsStepFunc(
-		na,
-		areRestricted(),
-		count,
-		helpers.IntCompareFuncLessThan,
-		helpers.IntCompareFuncGreaterThanOrEqualTo,
-	)
-	res := na.clone()
-	res.SetBuilder(na.GetBuilder().Assess("atLeastNAreRestricted", stepFn))
+	return na.AtLeastNMatch("atLeastNAreRestricted", count, isRestricted)
+}
 
-	return res
+// NoneAreRestricted asserts that none of the Namespaces use the default "restricted" pod security standard.
+func (na NamespaceAssertion) NoneAreRestricted() NamespaceAssertion {
+	return na.NoneMatch("noneAreRestricted", isRestricted)
 }
 
 // NewNamespaceAssertion creates a new NamespaceAssertion.
 func NewNamespaceAssertion(opts ...assertion.Option) NamespaceAssertion {
 	return NamespaceAssertion{
-		Assertion: assertion.NewAssertion(
-			append(
-				[]assertion.Option{assertion.WithBuilder(features.New("Namespace").WithLabel("type", "namespace"))},
-				opts...,
-			)...,
+		ResourceAssertion: assertion.NewResourceAssertion(
+			corev1.SchemeGroupVersion.WithResource("namespaces"),
+			func(ra assertion.ResourceAssertion[corev1.Namespace, NamespaceAssertion]) NamespaceAssertion {
+				return NamespaceAssertion{ResourceAssertion: ra}
+			},
+			features.New("Namespace").WithLabel("type", "namespace"),
+			opts...,
 		),
 	}
 }
@@ -1,6 +1,7 @@
 package pods_test
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
@@ -64,6 +65,69 @@ func Test_1Pod_Success(t *testing.T) {
 				).Exists().IsReady()
 			},
 		},
+		{
+			Name: "NoneExist_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return pods.NewPodAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-pod"),
+				).NoneExist()
+			},
+		},
+		{
+			Name: "IsNotReady_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return pods.NewPodAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-pod"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(readyPodPath),
+					),
+				).Exists().IsNotReady()
+			},
+		},
+		{
+			Name: "LogsContain_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return pods.NewPodAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-pod"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(readyPodPath),
+					),
+				).IsReady().LogsContain("start worker process", pods.InContainer("test")).LogsDoNotContain(`\[emerg\]`)
+			},
+		},
+		{
+			Name: "ExecOutputMatches_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return pods.NewPodAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-pod"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(readyPodPath),
+					),
+				).
+					IsReady().
+					ExecSucceeds("test", "nginx", "-t").
+					ExecOutputMatches("test", `location = /healthz`, "cat", "/etc/nginx/conf.d/default.conf")
+			},
+		},
+		{
+			Name: "RespondsHTTP_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return pods.NewPodAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithResourceName("test-pod"),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(readyPodPath),
+					),
+				).IsReady().RespondsHTTP("/readyz", "80", http.StatusOK, `"ready":\s*true`)
+			},
+		},
 	}
 
 	testhelpers.TestSuccessfulAsserts(t, testEnv, asserts...)
@@ -125,6 +189,21 @@ func Test_1Pod_Fail(t *testing.T) {
 				).Exists().IsReady()
 			},
 		},
+		{
+			Name: "NoneExist_Name",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return pods.NewPodAssertion(
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithRequireT(t),
+					assertion.WithResourceName("test-pod"),
+					assertion.WithTimeout(500*time.Millisecond),
+					assertion.WithInterval(100*time.Millisecond),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(readyPodPath),
+					),
+				).NoneExist()
+			},
+		},
 	}
 
 	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
@@ -1,46 +1,16 @@
 package pods
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/e2e-framework/pkg/features"
 
 	"github.com/DWSR/kubeassert-go/internal/assertion"
-	helpers "github.com/DWSR/kubeassert-go/internal/assertionhelpers"
 )
 
-// PodAssertion is a wrapper around the assertion.Assertion type and provides a set of assertions for Kubernetes Pods.
+// PodAssertion is a wrapper around the assertion.ResourceAssertion type and provides a set of assertions for
+// Kubernetes Pods.
 type PodAssertion struct {
-	assertion.Assertion
-}
-
-func (pa PodAssertion) clone() PodAssertion {
-	return PodAssertion{
-		Assertion: assertion.Clone(pa.Assertion),
-	}
-}
-
-// Exists asserts that exactly one Pod exists in the cluster that matches the provided options.
-func (pa PodAssertion) Exists() PodAssertion {
-	return pa.ExactlyNExist(1)
-}
-
-// ExactlyNExist asserts that exactly N Pods exist in the cluster that match the provided options.
-func (pa PodAssertion) ExactlyNExist(count int) PodAssertion {
-	stepFn := helpers.AsStepFunc(pa, exist(), count, helpers.IntCompareFuncEqualTo, nil)
-
-	res := pa.clone()
-	res.SetBuilder(res.GetBuilder().Assess("exactlyNExist", stepFn))
-
-	return res
-}
-
-// AtLeastNExist asserts that at least N Pods exist in the cluster that match the provided options.
-func (pa PodAssertion) AtLeastNExist(count int) PodAssertion {
-	stepFn := helpers.AsStepFunc(pa, exist(), count, helpers.IntCompareFuncGreaterThanOrEqualTo, nil)
-
-	res := pa.clone()
-	res.SetBuilder(res.GetBuilder().Assess("atLeastNExist", stepFn))
-
-	return res
+	assertion.ResourceAssertion[corev1.Pod, PodAssertion]
 }
 
 // IsReady asserts that exactly one Pod is ready in the cluster that matches the provided options.
@@ -50,36 +20,44 @@ func (pa PodAssertion) IsReady() PodAssertion {
 
 // ExactlyNAreReady asserts that exactly N Pods are ready in the cluster that match the provided options.
 func (pa PodAssertion) ExactlyNAreReady(count int) PodAssertion {
-	stepFn := helpers.AsStepFunc(pa, areReady(), count, helpers.IntCompareFuncNotEqualTo, helpers.IntCompareFuncEqualTo)
-	res := pa.clone()
-	res.SetBuilder(pa.GetBuilder().Assess("exactlyNAreReady", stepFn))
-
-	return res
+	return pa.ExactlyNMatch("exactlyNAreReady", count, isReady)
 }
 
 // AtLeastNAreReady asserts that at least N Pods are ready in the cluster that match the provided options.
 func (pa PodAssertion) AtLeastNAreReady(count int) PodAssertion {
-	stepFn := helpers.AsStepFunc(
-		pa,
-		areReady(),
-		count,
-		helpers.IntCompareFuncLessThan,
-		helpers.IntCompareFuncGreaterThanOrEqualTo,
-	)
-	res := pa.clone()
-	res.SetBuilder(pa.GetBuilder().Assess("atLeastNAreReady", stepFn))
+	return pa.AtLeastNMatch("atLeastNAreReady", count, isReady)
+}
+
+// IsNotReady asserts that exactly one Pod is not ready in the cluster that matches the provided options.
+func (pa PodAssertion) IsNotReady() PodAssertion {
+	return pa.ExactlyNAreNotReady(1)
+}
+
+// ExactlyNAreNotReady asserts that exactly N Pods are not ready in the cluster that match the provided options.
+func (pa PodAssertion) ExactlyNAreNotReady(count int) PodAssertion {
+	return pa.ExactlyNMatch("exactlyNAreNotReady", count, assertion.Not(isReady))
+}
+
+// AtLeastNAreNotReady asserts that at least N Pods are not ready in the cluster that match the provided options.
+func (pa PodAssertion) AtLeastNAreNotReady(count int) PodAssertion {
+	return pa.AtLeastNMatch("atLeastNAreNotReady", count, assertion.Not(isReady))
+}
 
-	return res
+// NoneAreReady asserts that none of the Pods in the cluster that match the provided options are ready.
+func (pa PodAssertion) NoneAreReady() PodAssertion {
+	return pa.NoneMatch("noneAreReady", isReady)
 }
 
 // NewPodAssertion creates a new PodAssertion with the provided options.
 func NewPodAssertion(opts ...assertion.Option) PodAssertion {
 	return PodAssertion{
-		Assertion: assertion.NewAssertion(
-			append(
-				[]assertion.Option{assertion.WithBuilder(features.New("Pod").WithLabel("type", "pod"))},
-				opts...,
-			)...,
+		ResourceAssertion: assertion.NewResourceAssertion(
+			corev1.SchemeGroupVersion.WithResource("pods"),
+			func(ra assertion.ResourceAssertion[corev1.Pod, PodAssertion]) PodAssertion {
+				return PodAssertion{ResourceAssertion: ra}
+			},
+			features.New("Pod").WithLabel("type", "pod"),
+			opts...,
 		),
 	}
 }
@@ -0,0 +1,104 @@
+package pods
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	corev1 "k8s.io/api/core/v1"
+	utilexec "k8s.io/client-go/util/exec"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/clients"
+)
+
+var (
+	// ErrEmptyCommand is returned when a command to run in a container is empty.
+	ErrEmptyCommand = errors.New("command must not be empty")
+	// ErrExecNotSupported is returned when the clients of a cluster cannot run commands in containers.
+	ErrExecNotSupported = errors.New("running commands in containers is not supported by the cluster")
+)
+
+// ExecSucceeds asserts that exactly one Pod matches the provided options and that the command exits successfully when
+// it is run in the container (e.g. ExecSucceeds("app", "test", "-f", "/etc/app/config.yaml")). When the container is
+// empty, the command runs in the default container of the Pod.
+func (pa PodAssertion) ExecSucceeds(container string, command ...string) PodAssertion {
+	return pa.ExactlyNExecSucceed(1, container, command...)
+}
+
+// ExactlyNExecSucceed asserts that exactly N Pods match the provided options and that the command exits successfully in
+// the container of exactly N of them.
+func (pa PodAssertion) ExactlyNExecSucceed(count int, container string, command ...string) PodAssertion {
+	return pa.ExactlyNMatchInCluster("exactlyNExecSucceed", count, execMatches(container, "", command))
+}
+
+// AtLeastNExecSucceed asserts that at least N Pods match the provided options and that the command exits successfully
+// in the container of at least N of them.
+func (pa PodAssertion) AtLeastNExecSucceed(count int, container string, command ...string) PodAssertion {
+	return pa.AtLeastNMatchInCluster("atLeastNExecSucceed", count, execMatches(container, "", command))
+}
+
+// ExecOutputMatches asserts that exactly one Pod matches the provided options and that the command exits successfully
+// when it is run in the container, with a standard output that contains a match for the regular expression (e.g.
+// ExecOutputMatches("app", "^listen: 8080$", "cat", "/etc/app/config.yaml")).
+func (pa PodAssertion) ExecOutputMatches(container, pattern string, command ...string) PodAssertion {
+	return pa.ExactlyNExecOutputsMatch(1, container, pattern, command...)
+}
+
+// ExactlyNExecOutputsMatch asserts that exactly N Pods match the provided options and that the command exits
+// successfully in the container of exactly N of them, with a standard output that contains a match for the regular
+// expression.
+func (pa PodAssertion) ExactlyNExecOutputsMatch(count int, container, pattern string, command ...string) PodAssertion {
+	return pa.ExactlyNMatchInCluster("exactlyNExecOutputsMatch", count, execMatches(container, pattern, command))
+}
+
+// AtLeastNExecOutputsMatch asserts that at least N Pods match the provided options and that the command exits
+// successfully in the container of at least N of them, with a standard output that contains a match for the regular
+// expression.
+func (pa PodAssertion) AtLeastNExecOutputsMatch(count int, container, pattern string, command ...string) PodAssertion {
+	return pa.AtLeastNMatchInCluster("atLeastNExecOutputsMatch", count, execMatches(container, pattern, command))
+}
+
+// execMatches returns a ClusterPredicate that is satisfied by Pods in which the command exits successfully and, if the
+// pattern is not empty, writes a match for it to its standard output. A command that exits with a non-zero status does
+// not satisfy it, while one that cannot be run (e.g. the request is forbidden) fails with an error.
+func execMatches(container, pattern string, command []string) assertion.ClusterPredicate[corev1.Pod] {
+	re, compileErr := regexp.Compile(pattern)
+
+	return func(_ context.Context, cfg *envconf.Config) (assertion.ContextPredicate[corev1.Pod], error) {
+		switch {
+		case compileErr != nil:
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidPattern, pattern, compileErr)
+		case len(command) == 0:
+			return nil, ErrEmptyCommand
+		}
+
+		shared, err := clients.ForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if shared.Exec == nil {
+			return nil, ErrExecNotSupported
+		}
+
+		opts := &corev1.PodExecOptions{Container: container, Command: command}
+
+		return func(ctx context.Context, pod corev1.Pod) (bool, error) {
+			stdout, _, err := shared.Exec.PodExec(ctx, pod.Namespace, pod.Name, opts)
+
+			var exitErr utilexec.ExitError
+
+			switch {
+			case errors.As(err, &exitErr):
+				return false, nil
+			case err != nil:
+				return false, fmt.Errorf("running %q: %w", command[0], err)
+			}
+
+			return re.Match(stdout), nil
+		}, nil
+	}
+}
@@ -0,0 +1,37 @@
+package pods
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+)
+
+// RespondsHTTP asserts that exactly one Pod matches the provided options and that it responds to a GET request for the
+// path on the port (a name or number) with the status code and a body that contains a match for the regular expression
+// (e.g. RespondsHTTP("/healthz", "8080", 200, `"status":\s*"UP"`)). The request is sent through the proxy subresource
+// of the API server, so the Pod does not need to be exposed. An empty pattern matches any body.
+func (pa PodAssertion) RespondsHTTP(path, port string, status int, bodyPattern string) PodAssertion {
+	return pa.ExactlyNRespondHTTP(1, path, port, status, bodyPattern)
+}
+
+// ExactlyNRespondHTTP asserts that exactly N Pods match the provided options and that exactly N of them respond to a
+// GET request for the path on the port with the status code and a body that contains a match for the regular
+// expression.
+func (pa PodAssertion) ExactlyNRespondHTTP(count int, path, port string, status int, bodyPattern string) PodAssertion {
+	return pa.ExactlyNMatchInCluster(
+		"exactlyNRespondHTTP",
+		count,
+		assertion.RespondsHTTP[corev1.Pod]("pods", path, port, status, bodyPattern),
+	)
+}
+
+// AtLeastNRespondHTTP asserts that at least N Pods match the provided options and that at least N of them respond to a
+// GET request for the path on the port with the status code and a body that contains a match for the regular
+// expression.
+func (pa PodAssertion) AtLeastNRespondHTTP(count int, path, port string, status int, bodyPattern string) PodAssertion {
+	return pa.AtLeastNMatchInCluster(
+		"atLeastNRespondHTTP",
+		count,
+		assertion.RespondsHTTP[corev1.Pod]("pods", path, port, status, bodyPattern),
+	)
+}
@@ -0,0 +1,182 @@
+package pods
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/e2e-framework/pkg/envconf"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/clients"
+)
+
+// LogOption configures which logs of a Pod are read.
+type LogOption func(*corev1.PodLogOptions)
+
+// DefaultTailLines is the number of lines read from the end of the logs of each container by LogsContain and its
+// variants unless TailLines or AllLines is used, so that the whole log of a long-running Pod is not downloaded every
+// time a check is evaluated. LogsDoNotContain and its variants read every line by default, as a match in an older line
+// would otherwise be missed.
+const DefaultTailLines = 10000
+
+var (
+	// ErrInvalidPattern is returned when a pattern is not a valid regular expression.
+	ErrInvalidPattern = assertion.ErrInvalidPattern
+	// ErrLogsNotSupported is returned when the clients of a cluster cannot read logs.
+	ErrLogsNotSupported = errors.New("reading logs is not supported by the cluster")
+)
+
+// InContainer reads the logs of the container with the name. By default, the logs of every container of the Pod are
+// read.
+func InContainer(name string) LogOption {
+	return func(opts *corev1.PodLogOptions) {
+		opts.Container = name
+	}
+}
+
+// SinceTime only reads the lines logged at or after the time.
+func SinceTime(since time.Time) LogOption {
+	return func(opts *corev1.PodLogOptions) {
+		opts.SinceTime = &metav1.Time{Time: since}
+	}
+}
+
+// TailLines only reads the last n lines of the logs of each container. By default, the last DefaultTailLines lines are
+// read when looking for a match and every line is read when asserting that there is none.
+func TailLines(n int64) LogOption {
+	return func(opts *corev1.PodLogOptions) {
+		opts.TailLines = &n
+	}
+}
+
+// AllLines reads every line of the logs of each container instead of the last DefaultTailLines lines when looking for a
+// match. Combine it with SinceTime to avoid downloading the whole log of long-running Pods.
+func AllLines() LogOption {
+	return func(opts *corev1.PodLogOptions) {
+		opts.TailLines = nil
+	}
+}
+
+// Previous reads the logs of the previous instance of the containers (e.g. before they crashed and were restarted).
+func Previous() LogOption {
+	return func(opts *corev1.PodLogOptions) {
+		opts.Previous = true
+	}
+}
+
+// LogsContain asserts that exactly one Pod that matches the provided options has logs that contain a match for the
+// regular expression.
+func (pa PodAssertion) LogsContain(pattern string, opts ...LogOption) PodAssertion {
+	return pa.ExactlyNLogsContain(1, pattern, opts...)
+}
+
+// ExactlyNLogsContain asserts that exactly N Pods that match the provided options have logs that contain a match for
+// the regular expression.
+func (pa PodAssertion) ExactlyNLogsContain(count int, pattern string, opts ...LogOption) PodAssertion {
+	return pa.ExactlyNMatchInCluster("exactlyNLogsContain", count, logsMatch(pattern, true, opts))
+}
+
+// AtLeastNLogsContain asserts that at least N Pods that match the provided options have logs that contain a match for
+// the regular expression.
+func (pa PodAssertion) AtLeastNLogsContain(count int, pattern string, opts ...LogOption) PodAssertion {
+	return pa.AtLeastNMatchInCluster("atLeastNLogsContain", count, logsMatch(pattern, true, opts))
+}
+
+// LogsDoNotContain asserts that at least one Pod matches the provided options and that none of their logs contain a
+// match for the regular expression (e.g. "failed to load config"). Every line of the logs is read unless TailLines or
+// SinceTime is used, and the check fails with an error when the logs of a Pod cannot be read.
+func (pa PodAssertion) LogsDoNotContain(pattern string, opts ...LogOption) PodAssertion {
+	return pa.WhereInCluster("logsDoNotContain", logsMatch(pattern, false, opts))
+}
+
+// ExactlyNLogsDoNotContain asserts that exactly N Pods that match the provided options have logs that do not contain a
+// match for the regular expression.
+func (pa PodAssertion) ExactlyNLogsDoNotContain(count int, pattern string, opts ...LogOption) PodAssertion {
+	return pa.ExactlyNMatchInCluster("exactlyNLogsDoNotContain", count, logsMatch(pattern, false, opts))
+}
+
+// AtLeastNLogsDoNotContain asserts that at least N Pods that match the provided options have logs that do not contain a
+// match for the regular expression.
+func (pa PodAssertion) AtLeastNLogsDoNotContain(count int, pattern string, opts ...LogOption) PodAssertion {
+	return pa.AtLeastNMatchInCluster("atLeastNLogsDoNotContain", count, logsMatch(pattern, false, opts))
+}
+
+// logsMatch returns a ClusterPredicate that is satisfied by Pods whose logs contain a match for the pattern, or do not
+// contain one when contain is false. It fails with the errors of the containers whose logs cannot be read unless the
+// logs of another container already decide the result.
+func logsMatch(pattern string, contain bool, opts []LogOption) assertion.ClusterPredicate[corev1.Pod] {
+	re, compileErr := regexp.Compile(pattern)
+
+	return func(_ context.Context, cfg *envconf.Config) (assertion.ContextPredicate[corev1.Pod], error) {
+		if compileErr != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidPattern, pattern, compileErr)
+		}
+
+		shared, err := clients.ForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if shared.Logs == nil {
+			return nil, ErrLogsNotSupported
+		}
+
+		return func(ctx context.Context, pod corev1.Pod) (bool, error) {
+			var errs []error
+
+			for _, logOpts := range podLogOptions(pod, contain, opts) {
+				logs, err := shared.Logs.PodLogs(ctx, pod.Namespace, pod.Name, logOpts)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("reading logs of container %q: %w", logOpts.Container, err))
+
+					continue
+				}
+
+				if re.Match(logs) {
+					return contain, nil
+				}
+			}
+
+			if len(errs) > 0 {
+				return false, errors.Join(errs...)
+			}
+
+			return !contain, nil
+		}, nil
+	}
+}
+
+// podLogOptions returns the options used to read the logs of each selected container of the Pod. Only the last
+// DefaultTailLines lines are read by default when looking for a match, but every line is read by default when asserting
+// that there is none so that older lines are never skipped.
+func podLogOptions(pod corev1.Pod, contain bool, opts []LogOption) []*corev1.PodLogOptions {
+	base := &corev1.PodLogOptions{}
+
+	if contain {
+		tailLines := int64(DefaultTailLines)
+		base.TailLines = &tailLines
+	}
+
+	for _, opt := range opts {
+		opt(base)
+	}
+
+	if base.Container != "" {
+		return []*corev1.PodLogOptions{base}
+	}
+
+	logOpts := make([]*corev1.PodLogOptions, 0, len(pod.Spec.Containers))
+
+	for _, container := range pod.Spec.Containers {
+		containerOpts := base.DeepCopy()
+		containerOpts.Container = container.Name
+		logOpts = append(logOpts, containerOpts)
+	}
+
+	return logOpts
+}
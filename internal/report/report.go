@@ -0,0 +1,236 @@
+// report records the outcome of each check made by assertions and writes them as JUnit XML or JSON so that CI systems
+// can show the history of individual checks (e.g. "isAvailable") across runs.
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+type (
+	// Reporter collects Records. It is safe for concurrent use so that a single Reporter can be shared by assertions
+	// whose Features run in parallel.
+	Reporter struct {
+		mu      sync.Mutex
+		records []Record
+	}
+
+	// Record is the outcome of a single check made by an assertion.
+	Record struct {
+		// Kind is the kind of the resources that the assertion is about (e.g. "Deployment").
+		Kind string
+		// Feature is the name of the e2e-framework Feature of the assertion, which defaults to the kind.
+		Feature string
+		// LabelSelector is the label selector used to select resources.
+		LabelSelector string
+		// FieldSelector is the field selector used to select resources.
+		FieldSelector string
+		// Check is the name of the check (e.g. "exactlyNAreAvailable").
+		Check string
+		// Start is when the check started.
+		Start time.Time
+		// Duration is how long the check took to be satisfied or to fail.
+		Duration time.Duration
+		// Passed is true if the check was satisfied.
+		Passed bool
+		// Diagnostic describes the last observed state of the resources.
+		Diagnostic string
+	}
+
+	// jsonRecord is the JSON representation of a Record.
+	jsonRecord struct {
+		Kind            string    `json:"kind"`
+		Feature         string    `json:"feature,omitempty"`
+		LabelSelector   string    `json:"labelSelector,omitempty"`
+		FieldSelector   string    `json:"fieldSelector,omitempty"`
+		Check           string    `json:"check"`
+		Start           time.Time `json:"start"`
+		DurationSeconds float64   `json:"durationSeconds"`
+		Passed          bool      `json:"passed"`
+		Diagnostic      string    `json:"diagnostic,omitempty"`
+	}
+
+	junitTestSuites struct {
+		XMLName  xml.Name         `xml:"testsuites"`
+		Tests    int              `xml:"tests,attr"`
+		Failures int              `xml:"failures,attr"`
+		Time     string           `xml:"time,attr"`
+		Suites   []junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name      string          `xml:"name,attr"`
+		Tests     int             `xml:"tests,attr"`
+		Failures  int             `xml:"failures,attr"`
+		Time      string          `xml:"time,attr"`
+		Timestamp string          `xml:"timestamp,attr"`
+		Cases     []junitTestCase `xml:"testcase"`
+	}
+
+	junitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Time      string        `xml:"time,attr"`
+		Failure   *junitFailure `xml:"failure,omitempty"`
+		SystemOut string        `xml:"system-out,omitempty"`
+	}
+
+	junitFailure struct {
+		Message string `xml:"message,attr"`
+		Text    string `xml:",chardata"`
+	}
+)
+
+// NewReporter creates an empty Reporter.
+func NewReporter() *Reporter {
+	return &Reporter{}
+}
+
+// Record adds a Record to the Reporter.
+func (r *Reporter) Record(record Record) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.records = append(r.records, record)
+}
+
+// Records returns the Records added to the Reporter in the order they were added.
+func (r *Reporter) Records() []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Record(nil), r.records...)
+}
+
+// Failed returns true if any Record did not pass.
+func (r *Reporter) Failed() bool {
+	for _, record := range r.Records() {
+		if !record.Passed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WriteJSON writes the Records as a JSON array. Durations are written in seconds.
+func (r *Reporter) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	records := r.Records()
+	jsonRecords := make([]jsonRecord, 0, len(records))
+
+	for _, record := range records {
+		jsonRecords = append(jsonRecords, jsonRecord{
+			Kind:            record.Kind,
+			Feature:         record.Feature,
+			LabelSelector:   record.LabelSelector,
+			FieldSelector:   record.FieldSelector,
+			Check:           record.Check,
+			Start:           record.Start,
+			DurationSeconds: record.Duration.Seconds(),
+			Passed:          record.Passed,
+			Diagnostic:      record.Diagnostic,
+		})
+	}
+
+	return encoder.Encode(jsonRecords)
+}
+
+// WriteJUnit writes the Records as JUnit XML. There is a test suite for each kind and a test case for each check,
+// named after the check and classified by the selectors used so that the history of a check can be followed across
+// runs. The diagnostic is included in the failure of failed checks.
+func (r *Reporter) WriteJUnit(writer io.Writer) error {
+	records := r.Records()
+	suites := junitTestSuites{}
+	suiteIndex := map[string]int{}
+	suiteTimes := []time.Duration{}
+
+	var total time.Duration
+
+	for _, record := range records {
+		idx, ok := suiteIndex[record.Kind]
+		if !ok {
+			idx = len(suites.Suites)
+			suiteIndex[record.Kind] = idx
+			suiteTimes = append(suiteTimes, 0)
+			suites.Suites = append(suites.Suites, junitTestSuite{
+				Name:      record.Kind,
+				Timestamp: record.Start.UTC().Format(time.RFC3339),
+			})
+		}
+
+		testCase := junitTestCase{
+			Name:      record.Check,
+			ClassName: className(record),
+			Time:      seconds(record.Duration),
+		}
+
+		suite := &suites.Suites[idx]
+		suite.Tests++
+		suites.Tests++
+
+		if record.Passed {
+			testCase.SystemOut = record.Diagnostic
+		} else {
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("check %q was not satisfied", record.Check),
+				Text:    record.Diagnostic,
+			}
+			suite.Failures++
+			suites.Failures++
+		}
+
+		suite.Cases = append(suite.Cases, testCase)
+		suiteTimes[idx] += record.Duration
+		total += record.Duration
+	}
+
+	for i := range suites.Suites {
+		suites.Suites[i].Time = seconds(suiteTimes[i])
+	}
+
+	suites.Time = seconds(total)
+
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(writer, "\n")
+
+	return err
+}
+
+func className(record Record) string {
+	selectors := record.FieldSelector
+
+	if record.LabelSelector != "" {
+		if selectors != "" {
+			selectors += ","
+		}
+
+		selectors += record.LabelSelector
+	}
+
+	if selectors == "" {
+		return record.Kind
+	}
+
+	return fmt.Sprintf("%s[%s]", record.Kind, selectors)
+}
+
+func seconds(duration time.Duration) string {
+	return fmt.Sprintf("%.3f", duration.Seconds())
+}
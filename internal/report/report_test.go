@@ -0,0 +1,106 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DWSR/kubeassert-go/internal/report"
+)
+
+func newReporter() *report.Reporter {
+	reporter := report.NewReporter()
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	reporter.Record(report.Record{
+		Kind:          "Deployment",
+		FieldSelector: "metadata.name=coredns",
+		Check:         "exactlyNExist",
+		Start:         start,
+		Duration:      1500 * time.Millisecond,
+		Passed:        true,
+	})
+	reporter.Record(report.Record{
+		Kind:          "Deployment",
+		Feature:       "coredns is available",
+		FieldSelector: "metadata.name=coredns",
+		LabelSelector: "app=coredns",
+		Check:         "exactlyNAreAvailable",
+		Start:         start,
+		Duration:      30 * time.Second,
+		Passed:        false,
+		Diagnostic:    `check "exactlyNAreAvailable": expected exactly 1`,
+	})
+	reporter.Record(report.Record{Kind: "Namespace", Check: "exactlyNExist", Start: start, Passed: true})
+
+	return reporter
+}
+
+func TestReporter_WriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, newReporter().WriteJUnit(&buf))
+
+	var suites struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name     string `xml:"name,attr"`
+			Tests    int    `xml:"tests,attr"`
+			Failures int    `xml:"failures,attr"`
+			Time     string `xml:"time,attr"`
+			Cases    []struct {
+				Name      string `xml:"name,attr"`
+				ClassName string `xml:"classname,attr"`
+				Failure   *struct {
+					Text string `xml:",chardata"`
+				} `xml:"failure"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &suites))
+	require.Equal(t, 3, suites.Tests)
+	require.Equal(t, 1, suites.Failures)
+	require.Len(t, suites.Suites, 2)
+
+	deployments := suites.Suites[0]
+	require.Equal(t, "Deployment", deployments.Name)
+	require.Equal(t, 2, deployments.Tests)
+	require.Equal(t, 1, deployments.Failures)
+	require.Equal(t, "31.500", deployments.Time)
+	require.Equal(t, "Deployment[metadata.name=coredns]", deployments.Cases[0].ClassName)
+	require.Nil(t, deployments.Cases[0].Failure)
+	require.Equal(t, "exactlyNAreAvailable", deployments.Cases[1].Name)
+	require.Equal(t, "Deployment[metadata.name=coredns,app=coredns]", deployments.Cases[1].ClassName)
+	require.NotNil(t, deployments.Cases[1].Failure)
+	require.Contains(t, deployments.Cases[1].Failure.Text, "expected exactly 1")
+
+	require.Equal(t, "Namespace", suites.Suites[1].Cases[0].ClassName)
+}
+
+func TestReporter_WriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, newReporter().WriteJSON(&buf))
+
+	var records []map[string]any
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &records))
+	require.Len(t, records, 3)
+	require.Equal(t, "exactlyNAreAvailable", records[1]["check"])
+	require.InDelta(t, 30.0, records[1]["durationSeconds"], 0.001)
+	require.Equal(t, false, records[1]["passed"])
+	require.Equal(t, "app=coredns", records[1]["labelSelector"])
+	require.Equal(t, "coredns is available", records[1]["feature"])
+	require.NotContains(t, records[0], "feature")
+}
+
+func TestReporter_Failed(t *testing.T) {
+	require.True(t, newReporter().Failed())
+	require.False(t, report.NewReporter().Failed())
+}
@@ -0,0 +1,54 @@
+// resources contains assertions for resources of any kind (e.g. custom resources) as unstructured objects. Fields
+// are asserted on with the JSONPath checks common to every assertion (e.g. HasField) or, by the names of nested fields,
+// with HasFieldValue.
+package resources
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/e2e-framework/pkg/features"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+)
+
+// ResourceAssertion is an assertion for resources of any kind, such as custom resources, which are evaluated as
+// unstructured objects.
+type ResourceAssertion struct {
+	assertion.ResourceAssertion[unstructured.Unstructured, ResourceAssertion]
+}
+
+// NewResourceAssertion creates a new ResourceAssertion for the resources identified by the GroupVersionResource (e.g.
+// certificates.v1.cert-manager.io) or GroupVersionKind (e.g. cert-manager.io/v1, Kind=Certificate) with the supplied
+// options. The resource and its scope are resolved with the RESTMapper of the cluster when the assertion is evaluated,
+// so the resource does not need to be served when the assertion is created.
+func NewResourceAssertion[R schema.GroupVersionResource | schema.GroupVersionKind](
+	resource R,
+	opts ...assertion.Option,
+) ResourceAssertion {
+	var (
+		gvr  schema.GroupVersionResource
+		gvk  schema.GroupVersionKind
+		name string
+	)
+
+	switch res := any(resource).(type) {
+	case schema.GroupVersionResource:
+		gvr, name = res, res.Resource
+	case schema.GroupVersionKind:
+		gvk, name = res, res.Kind
+	}
+
+	return ResourceAssertion{
+		ResourceAssertion: assertion.NewMappedResourceAssertion(
+			gvr,
+			gvk,
+			func(ra assertion.ResourceAssertion[unstructured.Unstructured, ResourceAssertion]) ResourceAssertion {
+				return ResourceAssertion{ResourceAssertion: ra}
+			},
+			features.New(name).WithLabel("type", strings.ToLower(name)),
+			opts...,
+		),
+	}
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"strings"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+)
+
+// HasFieldValue asserts that exactly one resource that matches the provided options has the nested field (e.g. "spec",
+// "size") set to the value. It is equivalent to HasField with the JSONPath expression of the nested field and Equals,
+// so numbers are compared by value regardless of their type.
+func (ra ResourceAssertion) HasFieldValue(value any, fields ...string) ResourceAssertion {
+	return ra.ExactlyNHaveFieldValue(1, value, fields...)
+}
+
+// ExactlyNHaveFieldValue asserts that exactly N resources that match the provided options have the nested field set to
+// the value (see HasFieldValue).
+func (ra ResourceAssertion) ExactlyNHaveFieldValue(count int, value any, fields ...string) ResourceAssertion {
+	return ra.ExactlyNHaveField(count, nestedFieldPath(fields), assertion.Equals(value))
+}
+
+// AtLeastNHaveFieldValue asserts that at least N resources that match the provided options have the nested field set
+// to the value (see HasFieldValue).
+func (ra ResourceAssertion) AtLeastNHaveFieldValue(count int, value any, fields ...string) ResourceAssertion {
+	return ra.AtLeastNHaveField(count, nestedFieldPath(fields), assertion.Equals(value))
+}
+
+// nestedFieldPath returns the JSONPath expression that selects the nested field. Dots in field names (e.g. in the keys
+// of annotations) are escaped so that they are not treated as separators.
+func nestedFieldPath(fields []string) string {
+	var builder strings.Builder
+
+	builder.WriteString("{")
+
+	for _, field := range fields {
+		builder.WriteString(".")
+		builder.WriteString(strings.ReplaceAll(field, ".", `\.`))
+	}
+
+	builder.WriteString("}")
+
+	return builder.String()
+}
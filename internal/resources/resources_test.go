@@ -0,0 +1,182 @@
+package resources_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/e2e-framework/pkg/env"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/fakecluster"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+	"github.com/DWSR/kubeassert-go/internal/resources"
+	"github.com/DWSR/kubeassert-go/internal/testhelpers"
+)
+
+const (
+	crdPath     = "./testdata/crd.yaml"
+	widgetsPath = "./testdata/widgets.yaml"
+)
+
+var (
+	widgetResource = schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	widgetKind     = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+)
+
+func isSmall(obj unstructured.Unstructured) bool {
+	size, _, _ := unstructured.NestedInt64(obj.Object, "spec", "size")
+
+	return size < 2
+}
+
+func newTestEnv(t *testing.T, paths ...string) (env.Environment, *fakecluster.Cluster) {
+	t.Helper()
+
+	cluster, err := fakecluster.NewFromFiles(paths...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, cluster.Close()) })
+
+	return env.NewWithConfig(cluster.Config()), cluster
+}
+
+func Test_Resources_Success(t *testing.T) {
+	testEnv, _ := newTestEnv(t, crdPath, widgetsPath)
+
+	asserts := []testhelpers.SuccessfulAssert{
+		{
+			Name: "Exists_Resource",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(widgetResource).ExactlyNExist(3)
+			},
+		},
+		{
+			Name: "Exists_Kind",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(widgetKind, assertion.WithResourceName("first")).Exists()
+			},
+		},
+		{
+			Name: "Exists_Namespace",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(widgetKind, assertion.WithResourceNamespace("resources")).
+					ExactlyNExist(2)
+			},
+		},
+		{
+			Name: "HasField",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(
+					widgetKind,
+					assertion.WithResourceLabels(map[string]string{"app.kubernetes.io/name": "resources_test"}),
+					assertion.WithResourceName("first"),
+				).HasField("{.spec.color}", assertion.IsPresent())
+			},
+		},
+		{
+			Name: "AtLeastNHaveField",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(widgetResource).
+					AtLeastNHaveField(2, "{.spec.size}", assertion.Equals(3))
+			},
+		},
+		{
+			Name: "HasFieldValue",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(widgetKind, assertion.WithResourceName("first")).
+					HasFieldValue("team-a", "metadata", "annotations", "example.com/owner")
+			},
+		},
+		{
+			Name: "AtLeastNHaveFieldValue",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(widgetResource).AtLeastNHaveFieldValue(2, 3, "spec", "size")
+			},
+		},
+		{
+			Name: "Where",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(widgetResource, assertion.WithResourceNamespace("resources")).
+					ExactlyNWhere("isSmall", 1, isSmall)
+			},
+		},
+		{
+			Name: "ClusterScoped",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(
+					schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"},
+				).Exists()
+			},
+		},
+	}
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, asserts...)
+}
+
+func Test_Resources_Fail(t *testing.T) {
+	testEnv, _ := newTestEnv(t, crdPath, widgetsPath)
+
+	asserts := []testhelpers.FailingAssert{
+		{
+			Name: "HasField",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(
+					widgetKind,
+					assertion.WithRequireT(t),
+					assertion.WithResourceName("second"),
+					assertion.WithTimeout(100*time.Millisecond),
+					assertion.WithInterval(10*time.Millisecond),
+				).HasField("{.spec.size}", assertion.Equals(3))
+			},
+		},
+		{
+			Name: "ExactlyNHaveFieldValue",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(
+					widgetKind,
+					assertion.WithRequireT(t),
+					assertion.WithResourceNamespace("resources"),
+					assertion.WithTimeout(100*time.Millisecond),
+					assertion.WithInterval(10*time.Millisecond),
+				).ExactlyNHaveFieldValue(2, 3, "spec", "size")
+			},
+		},
+		{
+			Name: "NotServed",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return resources.NewResourceAssertion(
+					schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Gadget"},
+					assertion.WithRequireT(t),
+					assertion.WithTimeout(100*time.Millisecond),
+					assertion.WithInterval(10*time.Millisecond),
+				).NoneExist()
+			},
+		},
+	}
+
+	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
+}
+
+func Test_Resources_CRDAppliedLater(t *testing.T) {
+	testEnv, cluster := newTestEnv(t)
+
+	crd, err := manifests.ReadFiles(crdPath)
+	require.NoError(t, err)
+
+	widgets, err := manifests.ReadFiles(widgetsPath)
+	require.NoError(t, err)
+
+	assert := resources.NewResourceAssertion(
+		widgetKind,
+		assertion.WithInterval(10*time.Millisecond),
+		assertion.WithSetup(cluster.Play(fakecluster.ApplyAfter(50*time.Millisecond, append(crd, widgets...)...))),
+	).ExactlyNExist(3)
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, testhelpers.SuccessfulAssert{
+		Name:             "Exists",
+		SuccessfulAssert: func(_ require.TestingT) assertion.Assertion { return assert },
+	})
+}
@@ -0,0 +1,79 @@
+package services_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	helpers "github.com/DWSR/kubeassert-go/internal/assertionhelpers"
+	"github.com/DWSR/kubeassert-go/internal/services"
+	"github.com/DWSR/kubeassert-go/internal/testhelpers"
+)
+
+func Test_1Service_Success(t *testing.T) {
+	asserts := []testhelpers.SuccessfulAssert{
+		{
+			Name: "Exists_Labels",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return services.NewServiceAssertion(
+					assertion.WithResourceLabels(map[string]string{"app.kubernetes.io/name": "services_test"}),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithSetup(helpers.CreateResourceFromPathWithNamespaceFromEnv(servicePath)),
+				).Exists()
+			},
+		},
+		{
+			Name: "RespondsHTTP_Name",
+			SuccessfulAssert: func(_ require.TestingT) assertion.Assertion {
+				return services.NewServiceAssertion(
+					assertion.WithResourceName("test-service"),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithSetup(
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(configPath),
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(deployPath),
+						helpers.CreateResourceFromPathWithNamespaceFromEnv(servicePath),
+					),
+				).
+					RespondsHTTP("/healthz", "http", http.StatusOK, `"status":\s*"UP"`).
+					RespondsHTTP("/missing", "80", http.StatusNotFound, "")
+			},
+		},
+	}
+
+	testhelpers.TestSuccessfulAsserts(t, testEnv, asserts...)
+}
+
+func Test_1Service_Fail(t *testing.T) {
+	asserts := []testhelpers.FailingAssert{
+		{
+			Name: "Exists_Name",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return services.NewServiceAssertion(
+					assertion.WithRequireT(t),
+					assertion.WithTimeout(500*time.Millisecond),
+					assertion.WithInterval(100*time.Millisecond),
+					assertion.WithResourceName("test-service"),
+					assertion.WithResourceNamespaceFromTestEnv(),
+				).Exists()
+			},
+		},
+		{
+			Name: "RespondsHTTP_NoEndpoints",
+			FailingAssert: func(t require.TestingT) assertion.Assertion {
+				return services.NewServiceAssertion(
+					assertion.WithRequireT(t),
+					assertion.WithTimeout(500*time.Millisecond),
+					assertion.WithInterval(100*time.Millisecond),
+					assertion.WithResourceName("test-service"),
+					assertion.WithResourceNamespaceFromTestEnv(),
+					assertion.WithSetup(helpers.CreateResourceFromPathWithNamespaceFromEnv(servicePath)),
+				).RespondsHTTP("/healthz", "http", http.StatusOK, "")
+			},
+		},
+	}
+
+	testhelpers.TestFailingAsserts(t, testEnv, asserts...)
+}
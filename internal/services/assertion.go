@@ -0,0 +1,29 @@
+// services contains assertions for Kubernetes Services.
+package services
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/e2e-framework/pkg/features"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+)
+
+// ServiceAssertion is a wrapper around assertion.ResourceAssertion that provides additional functionality for
+// Services.
+type ServiceAssertion struct {
+	assertion.ResourceAssertion[corev1.Service, ServiceAssertion]
+}
+
+// NewServiceAssertion creates a new ServiceAssertion with the provided options.
+func NewServiceAssertion(opts ...assertion.Option) ServiceAssertion {
+	return ServiceAssertion{
+		ResourceAssertion: assertion.NewResourceAssertion(
+			corev1.SchemeGroupVersion.WithResource("services"),
+			func(ra assertion.ResourceAssertion[corev1.Service, ServiceAssertion]) ServiceAssertion {
+				return ServiceAssertion{ResourceAssertion: ra}
+			},
+			features.New("Service").WithLabel("type", "service"),
+			opts...,
+		),
+	}
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+)
+
+// RespondsHTTP asserts that exactly one Service matches the provided options and that it responds to a GET request for
+// the path on the port (a name or number) with the status code and a body that contains a match for the regular
+// expression (e.g. RespondsHTTP("/healthz", "http", 200, "UP")). The request is sent through the proxy subresource of
+// the API server to one of the Service's endpoints, so the Service does not need to be exposed outside of the
+// cluster. An empty pattern matches any body.
+func (sa ServiceAssertion) RespondsHTTP(path, port string, status int, bodyPattern string) ServiceAssertion {
+	return sa.ExactlyNRespondHTTP(1, path, port, status, bodyPattern)
+}
+
+// ExactlyNRespondHTTP asserts that exactly N Services match the provided options and that exactly N of them respond to
+// a GET request for the path on the port with the status code and a body that contains a match for the regular
+// expression.
+func (sa ServiceAssertion) ExactlyNRespondHTTP(
+	count int,
+	path, port string,
+	status int,
+	bodyPattern string,
+) ServiceAssertion {
+	return sa.ExactlyNMatchInCluster(
+		"exactlyNRespondHTTP",
+		count,
+		assertion.RespondsHTTP[corev1.Service]("services", path, port, status, bodyPattern),
+	)
+}
+
+// AtLeastNRespondHTTP asserts that at least N Services match the provided options and that at least N of them respond
+// to a GET request for the path on the port with the status code and a body that contains a match for the regular
+// expression.
+func (sa ServiceAssertion) AtLeastNRespondHTTP(
+	count int,
+	path, port string,
+	status int,
+	bodyPattern string,
+) ServiceAssertion {
+	return sa.AtLeastNMatchInCluster(
+		"atLeastNRespondHTTP",
+		count,
+		assertion.RespondsHTTP[corev1.Service]("services", path, port, status, bodyPattern),
+	)
+}
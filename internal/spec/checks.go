@@ -0,0 +1,154 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+)
+
+type (
+	// Check is a single check of an assertion. It is either the name of an assertion method without arguments
+	// (e.g. "isAvailable") or an object with a single key, the name of an assertion method, whose value is the method's
+	// argument (e.g. "atLeastNAreAvailable: 2"). Methods with more than one argument take a list of arguments.
+	Check struct {
+		// Method is the lowerCamelCase name of the assertion method.
+		Method string
+		// Args is the JSON encoded value of the method's arguments, if any.
+		Args json.RawMessage
+	}
+)
+
+//nolint:gochecknoglobals
+var durationType = reflect.TypeFor[time.Duration]()
+
+// UnmarshalJSON decodes a check from either a string or an object with a single key.
+func (c *Check) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &c.Method); err == nil {
+		c.Args = nil
+
+		return nil
+	}
+
+	var withArgs map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &withArgs); err != nil || len(withArgs) != 1 {
+		return fmt.Errorf("%w: %s must be a string or an object with a single key", ErrInvalidCheck, data)
+	}
+
+	for method, args := range withArgs {
+		c.Method = method
+		c.Args = args
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a check as a string when it has no arguments and as an object with a single key otherwise.
+func (c Check) MarshalJSON() ([]byte, error) {
+	if c.Args == nil {
+		return json.Marshal(c.Method)
+	}
+
+	return json.Marshal(map[string]json.RawMessage{c.Method: c.Args})
+}
+
+// apply calls the assertion method named by the check and returns the resulting assertion.
+//
+//nolint:ireturn
+func (c Check) apply(assert assertion.Assertion) (assertion.Assertion, error) {
+	assertValue := reflect.ValueOf(assert)
+
+	method := assertValue.MethodByName(exportedName(c.Method))
+	if !method.IsValid() || method.Type().NumOut() != 1 || method.Type().Out(0) != assertValue.Type() {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCheck, c.Method)
+	}
+
+	args, err := c.arguments(method.Type())
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", c.Method, err)
+	}
+
+	res, ok := method.Call(args)[0].Interface().(assertion.Assertion)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCheck, c.Method)
+	}
+
+	return res, nil
+}
+
+// arguments decodes the arguments of the check into the parameter types of the method.
+func (c Check) arguments(method reflect.Type) ([]reflect.Value, error) {
+	var raw []json.RawMessage
+
+	switch {
+	case method.NumIn() == 0 && c.Args == nil:
+		return nil, nil
+	case method.NumIn() == 1 && c.Args != nil:
+		raw = []json.RawMessage{c.Args}
+	case method.NumIn() > 1 && c.Args != nil:
+		if err := json.Unmarshal(c.Args, &raw); err != nil {
+			return nil, fmt.Errorf("%w: expected a list of %d arguments", ErrInvalidCheck, method.NumIn())
+		}
+	}
+
+	if len(raw) != method.NumIn() {
+		return nil, fmt.Errorf("%w: expected %d argument(s)", ErrInvalidCheck, method.NumIn())
+	}
+
+	args := make([]reflect.Value, method.NumIn())
+
+	for i := range args {
+		arg, err := decodeArgument(raw[i], method.In(i))
+		if err != nil {
+			return nil, err
+		}
+
+		args[i] = arg
+	}
+
+	return args, nil
+}
+
+// decodeArgument decodes a single argument. Durations are decoded from strings such as "5m".
+func decodeArgument(raw json.RawMessage, argType reflect.Type) (reflect.Value, error) {
+	arg := reflect.New(argType)
+
+	if argType == durationType {
+		var duration string
+
+		if err := json.Unmarshal(raw, &duration); err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %s is not a duration", ErrInvalidCheck, raw)
+		}
+
+		parsed, err := time.ParseDuration(duration)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrInvalidCheck, err)
+		}
+
+		arg.Elem().SetInt(int64(parsed))
+
+		return arg.Elem(), nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(arg.Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("%w: %s cannot be used as %s", ErrInvalidCheck, raw, argType)
+	}
+
+	return arg.Elem(), nil
+}
+
+// exportedName converts the lowerCamelCase name of a check to the name of the assertion method.
+func exportedName(name string) string {
+	first, size := utf8.DecodeRuneInString(name)
+
+	return string(unicode.ToUpper(first)) + name[size:]
+}
@@ -0,0 +1,169 @@
+package spec
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// schemaObject is a JSON schema (or part of one).
+type schemaObject = map[string]any
+
+// JSONSchema returns a JSON schema that describes documents in the APIVersion format. It is generated from the
+// assertion methods of each kind so that it always matches the checks accepted by Load and can be used by editors to
+// validate and complete assertion files.
+func JSONSchema() ([]byte, error) {
+	kindNames := make([]string, 0, len(kinds))
+	for kind := range kinds {
+		kindNames = append(kindNames, kind)
+	}
+
+	slices.Sort(kindNames)
+
+	assertionSchemas := make([]any, 0, len(kindNames))
+	for _, kind := range kindNames {
+		assertionSchemas = append(assertionSchemas, assertionSchema(kind))
+	}
+
+	schema := schemaObject{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"title":                "kubeassert " + APIVersion + " " + Kind,
+		"type":                 "object",
+		"required":             []string{"apiVersion", "kind", "assertions"},
+		"additionalProperties": false,
+		"properties": schemaObject{
+			"apiVersion": schemaObject{"const": APIVersion},
+			"kind":       schemaObject{"const": Kind},
+			"assertions": schemaObject{"type": "array", "items": schemaObject{"oneOf": assertionSchemas}},
+		},
+	}
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// assertionSchema returns the schema of an assertion of a single kind, including every check that can be applied to
+// it.
+func assertionSchema(kind string) schemaObject {
+	return schemaObject{
+		"type":                 "object",
+		"required":             []string{"kind", "checks"},
+		"additionalProperties": false,
+		"properties": schemaObject{
+			"kind":          schemaObject{"const": kind},
+			"namespace":     option(schemaObject{"type": "string"}, "WithNamespace"),
+			"resourceName":  option(schemaObject{"type": "string"}, "WithResourceName"),
+			"labels":        option(stringMapSchema(), "WithLabels"),
+			"labelSelector": option(schemaObject{"type": "string"}, "WithLabelSelector"),
+			"fields":        option(stringMapSchema(), "WithFields"),
+			"timeout":       option(durationSchema(), "WithTimeout"),
+			"interval":      option(durationSchema(), "WithInterval"),
+			"watch":         option(schemaObject{"type": "boolean"}, "WithWatch"),
+			"checks":        schemaObject{"type": "array", "items": checksSchema(kind)},
+		},
+	}
+}
+
+// option describes the schema of a field that corresponds to an assertion option.
+func option(schema schemaObject, name string) schemaObject {
+	schema["description"] = "Corresponds to " + name + "."
+
+	return schema
+}
+
+// checksSchema returns the schema of a single check of an assertion of the supplied kind.
+func checksSchema(kind string) schemaObject {
+	assertType := reflect.TypeOf(kinds[kind]())
+
+	var (
+		withoutArgs []string
+		withArgs    = schemaObject{}
+	)
+
+	for i := range assertType.NumMethod() {
+		method := assertType.Method(i)
+		if method.Type.NumOut() != 1 || method.Type.Out(0) != assertType {
+			continue
+		}
+
+		// The receiver is the first argument of a method obtained from a type.
+		args := make([]schemaObject, 0, method.Type.NumIn()-1)
+
+		for j := 1; j < method.Type.NumIn(); j++ {
+			if arg := argumentSchema(method.Type.In(j)); arg != nil {
+				args = append(args, arg)
+			}
+		}
+
+		name := unexportedName(method.Name)
+
+		switch {
+		case len(args) != method.Type.NumIn()-1:
+			// At least one argument (e.g. a Predicate) cannot be described declaratively.
+		case len(args) == 0:
+			withoutArgs = append(withoutArgs, name)
+		case len(args) == 1:
+			withArgs[name] = args[0]
+		default:
+			withArgs[name] = schemaObject{
+				"type":        "array",
+				"prefixItems": args,
+				"minItems":    len(args),
+				"maxItems":    len(args),
+			}
+		}
+	}
+
+	return schemaObject{
+		"oneOf": []schemaObject{
+			{"enum": withoutArgs},
+			{
+				"type":                 "object",
+				"minProperties":        1,
+				"maxProperties":        1,
+				"additionalProperties": false,
+				"properties":           withArgs,
+			},
+		},
+	}
+}
+
+// argumentSchema returns the schema of a single argument of an assertion method or nil if the argument cannot be
+// described declaratively.
+func argumentSchema(argType reflect.Type) schemaObject {
+	if argType == durationType {
+		return durationSchema()
+	}
+
+	//nolint:exhaustive
+	switch argType.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return schemaObject{"type": "integer"}
+	case reflect.String:
+		return schemaObject{"type": "string"}
+	case reflect.Bool:
+		return schemaObject{"type": "boolean"}
+	case reflect.Map:
+		if argType.Key().Kind() == reflect.String && argType.Elem().Kind() == reflect.String {
+			return stringMapSchema()
+		}
+	}
+
+	return nil
+}
+
+func stringMapSchema() schemaObject {
+	return schemaObject{"type": "object", "additionalProperties": schemaObject{"type": "string"}}
+}
+
+func durationSchema() schemaObject {
+	return schemaObject{"type": "string", "pattern": `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`}
+}
+
+// unexportedName converts the name of an assertion method to the lowerCamelCase name of a check.
+func unexportedName(name string) string {
+	first, size := utf8.DecodeRuneInString(name)
+
+	return string(unicode.ToLower(first)) + name[size:]
+}
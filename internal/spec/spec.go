@@ -0,0 +1,289 @@
+// spec loads assertions from declarative YAML or JSON files so that they can be written and run without Go. The format
+// is versioned and maps one-to-one onto the Go API: each assertion selects a kind, the options used to select resources
+// (e.g. "namespace" for WithNamespace) and a list of checks. Checks are the lowerCamelCase names of the kind's
+// assertion methods (e.g. "isAvailable" for DeploymentAssertion.IsAvailable) and take the method's arguments as their
+// value:
+//
+//	apiVersion: kubeassert/v1alpha1
+//	kind: AssertionSuite
+//	assertions:
+//	  - kind: Deployment
+//	    namespace: kube-system
+//	    resourceName: coredns
+//	    timeout: 5m
+//	    checks:
+//	      - exists
+//	      - atLeastNAreAvailable: 2
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/crds"
+	"github.com/DWSR/kubeassert-go/internal/deployments"
+	"github.com/DWSR/kubeassert-go/internal/events"
+	"github.com/DWSR/kubeassert-go/internal/namespaces"
+	"github.com/DWSR/kubeassert-go/internal/pdbs"
+	"github.com/DWSR/kubeassert-go/internal/pods"
+	"github.com/DWSR/kubeassert-go/internal/secrets"
+	"github.com/DWSR/kubeassert-go/internal/services"
+)
+
+type (
+	// Suite is a document in an assertion file.
+	Suite struct {
+		// APIVersion is the version of the format of the document. It must be APIVersion.
+		APIVersion string `json:"apiVersion"`
+		// Kind is the kind of the document. It must be Kind.
+		Kind string `json:"kind"`
+		// Assertions are the assertions in the document.
+		Assertions []Assertion `json:"assertions"`
+	}
+
+	// Assertion describes a single assertion. Each field corresponds to an assertion option.
+	Assertion struct {
+		// Kind is the kind of the resources that the assertion is about (e.g. Deployment).
+		Kind string `json:"kind"`
+		// Namespace corresponds to WithNamespace.
+		Namespace string `json:"namespace,omitempty"`
+		// ResourceName corresponds to WithResourceName.
+		ResourceName string `json:"resourceName,omitempty"`
+		// Labels corresponds to WithLabels.
+		Labels map[string]string `json:"labels,omitempty"`
+		// LabelSelector corresponds to WithLabelSelector.
+		LabelSelector string `json:"labelSelector,omitempty"`
+		// Fields corresponds to WithFields.
+		Fields map[string]string `json:"fields,omitempty"`
+		// Timeout corresponds to WithTimeout.
+		Timeout *metav1.Duration `json:"timeout,omitempty"`
+		// Interval corresponds to WithInterval.
+		Interval *metav1.Duration `json:"interval,omitempty"`
+		// Watch corresponds to WithWatch.
+		Watch bool `json:"watch,omitempty"`
+		// Checks are applied to the assertion in order.
+		Checks []Check `json:"checks"`
+	}
+
+	// kindConstructor creates an assertion of a single kind.
+	kindConstructor func(opts ...assertion.Option) assertion.Assertion
+)
+
+const (
+	decodeBufferSize = 4096
+
+	// APIVersion is the version of the assertion file format supported by the loader.
+	APIVersion = "kubeassert/v1alpha1"
+	// Kind is the kind of the documents in an assertion file.
+	Kind = "AssertionSuite"
+)
+
+var (
+	// ErrUnsupportedVersion is returned when a document's apiVersion or kind is not supported.
+	ErrUnsupportedVersion = errors.New("unsupported apiVersion or kind")
+	// ErrUnknownKind is returned when an assertion's kind is not supported.
+	ErrUnknownKind = errors.New("unknown kind")
+	// ErrUnknownCheck is returned when a check does not correspond to an assertion method of the assertion's kind.
+	ErrUnknownCheck = errors.New("unknown check")
+	// ErrInvalidCheck is returned when a check is malformed or its arguments do not match the assertion method.
+	ErrInvalidCheck = errors.New("invalid check")
+)
+
+//nolint:gochecknoglobals
+var kinds = map[string]kindConstructor{
+	"CustomResourceDefinition": func(opts ...assertion.Option) assertion.Assertion {
+		return crds.NewCRDAssertion(opts...)
+	},
+	"Deployment": func(opts ...assertion.Option) assertion.Assertion {
+		return deployments.NewDeploymentAssertion(opts...)
+	},
+	"Event": func(opts ...assertion.Option) assertion.Assertion {
+		return events.NewEventAssertion(opts...)
+	},
+	"Namespace": func(opts ...assertion.Option) assertion.Assertion {
+		return namespaces.NewNamespaceAssertion(opts...)
+	},
+	"Pod": func(opts ...assertion.Option) assertion.Assertion {
+		return pods.NewPodAssertion(opts...)
+	},
+	"PodDisruptionBudget": func(opts ...assertion.Option) assertion.Assertion {
+		return pdbs.NewPDBAssertion(opts...)
+	},
+	"Secret": func(opts ...assertion.Option) assertion.Assertion {
+		return secrets.NewSecretAssertion(opts...)
+	},
+	"Service": func(opts ...assertion.Option) assertion.Assertion {
+		return services.NewServiceAssertion(opts...)
+	},
+}
+
+// LoadFile loads the assertions in the YAML or JSON file at the supplied path. The options are applied to every
+// assertion before the options in the file.
+func LoadFile(path string, opts ...assertion.Option) ([]assertion.Assertion, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	asserts, err := Load(file, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return asserts, nil
+}
+
+// Load loads the assertions in a YAML or JSON stream. YAML streams may contain multiple documents separated by "---".
+// Unknown fields are rejected so that typos are not silently ignored. The options are applied to every assertion before
+// the options in the stream.
+func Load(reader io.Reader, opts ...assertion.Option) ([]assertion.Assertion, error) {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(reader, decodeBufferSize)
+
+	var asserts []assertion.Assertion
+
+	for doc := 0; ; doc++ {
+		var raw json.RawMessage
+
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return asserts, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", doc, err)
+		}
+
+		// Skip empty documents (e.g. a trailing "---").
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		suite, err := decodeSuite(raw)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", doc, err)
+		}
+
+		for i, spec := range suite.Assertions {
+			assert, err := spec.Build(opts...)
+			if err != nil {
+				return nil, fmt.Errorf("document %d: assertions[%d]: %w", doc, i, err)
+			}
+
+			asserts = append(asserts, assert)
+		}
+	}
+}
+
+func decodeSuite(raw json.RawMessage) (Suite, error) {
+	var suite Suite
+
+	// Check the version before decoding strictly so that documents in other versions report the version rather than
+	// unknown fields.
+	if err := json.Unmarshal(raw, &suite); err != nil {
+		return Suite{}, err
+	}
+
+	if suite.APIVersion != APIVersion || suite.Kind != Kind {
+		return Suite{}, fmt.Errorf(
+			"%w: %q %q, expected %q %q",
+			ErrUnsupportedVersion,
+			suite.APIVersion,
+			suite.Kind,
+			APIVersion,
+			Kind,
+		)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	suite = Suite{}
+
+	if err := decoder.Decode(&suite); err != nil {
+		return Suite{}, err
+	}
+
+	return suite, nil
+}
+
+// Build creates the assertion described by the spec. The options are applied before the options in the spec.
+//
+//nolint:ireturn
+func (s Assertion) Build(opts ...assertion.Option) (assertion.Assertion, error) {
+	newAssertion, ok := kinds[s.Kind]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKind, s.Kind)
+	}
+
+	specOpts, err := s.options()
+	if err != nil {
+		return nil, err
+	}
+
+	assert := newAssertion(append(slices.Clone(opts), specOpts...)...)
+
+	for i, chk := range s.Checks {
+		assert, err = chk.apply(assert)
+		if err != nil {
+			return nil, fmt.Errorf("checks[%d]: %w", i, err)
+		}
+	}
+
+	return assert, nil
+}
+
+func (s Assertion) options() ([]assertion.Option, error) {
+	var opts []assertion.Option
+
+	// WithFields replaces the fields set by WithNamespace and WithResourceName so it must be applied first.
+	if len(s.Fields) > 0 {
+		opts = append(opts, assertion.WithResourceFields(maps.Clone(s.Fields)))
+	}
+
+	if s.Namespace != "" {
+		opts = append(opts, assertion.WithResourceNamespace(s.Namespace))
+	}
+
+	if s.ResourceName != "" {
+		opts = append(opts, assertion.WithResourceName(s.ResourceName))
+	}
+
+	if len(s.Labels) > 0 {
+		opts = append(opts, assertion.WithResourceLabels(s.Labels))
+	}
+
+	if s.LabelSelector != "" {
+		reqs, err := labels.ParseToRequirements(s.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("labelSelector: %w", err)
+		}
+
+		opts = append(opts, assertion.WithLabelRequirements(reqs...))
+	}
+
+	if s.Timeout != nil {
+		opts = append(opts, assertion.WithTimeout(s.Timeout.Duration))
+	}
+
+	if s.Interval != nil {
+		opts = append(opts, assertion.WithInterval(s.Interval.Duration))
+	}
+
+	if s.Watch {
+		opts = append(opts, assertion.WithWatch())
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,174 @@
+package spec_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/e2e-framework/pkg/types"
+
+	"github.com/DWSR/kubeassert-go/internal/assertion"
+	"github.com/DWSR/kubeassert-go/internal/spec"
+)
+
+const (
+	header     = "apiVersion: kubeassert/v1alpha1\nkind: AssertionSuite\n"
+	schemaPath = "../../docs/schema/v1alpha1.json"
+
+	validSpec = `
+apiVersion: kubeassert/v1alpha1
+kind: AssertionSuite
+assertions:
+  - kind: Deployment
+    namespace: kube-system
+    resourceName: coredns
+    labelSelector: "tier in (control-plane)"
+    timeout: 5m
+    interval: 5s
+    checks:
+      - exists
+      - atLeastNAreAvailable: 2
+      - consistently: 1m
+      - isAvailable
+  - kind: Secret
+    labels:
+      app: test
+    checks:
+      - exactlyNHaveContent: [1, {key: value}]
+      - whereCEL: has(self.data.key)
+      - fieldMatches: ["{.metadata.name}", "^app-"]
+---
+apiVersion: kubeassert/v1alpha1
+kind: AssertionSuite
+assertions:
+  - kind: Namespace
+    resourceName: default
+    checks:
+      - exists
+  - kind: Service
+    namespace: default
+    resourceName: web
+    checks:
+      - respondsHTTP: [/healthz, http, 200, UP]
+`
+)
+
+func assessSteps(assert assertion.Assertion) []string {
+	var names []string
+
+	for _, step := range assertion.AsFeature(assert).Steps() {
+		if step.Level() == types.LevelAssess {
+			names = append(names, step.Name())
+		}
+	}
+
+	return names
+}
+
+func TestLoad(t *testing.T) {
+	asserts, err := spec.Load(strings.NewReader(validSpec))
+	require.NoError(t, err)
+	require.Len(t, asserts, 4)
+
+	deployment := asserts[0]
+	require.Equal(t, "Deployment", assertion.AsFeature(deployment).Name())
+	require.Equal(t, 5*time.Minute, deployment.GetTimeout())
+	require.Equal(t, 5*time.Second, deployment.GetInterval())
+	require.Equal(
+		t,
+		map[string]string{"metadata.namespace": "kube-system", "metadata.name": "coredns"},
+		deployment.GetFields(),
+	)
+	require.Len(t, deployment.GetLabelRequirements(), 1)
+	require.Equal(t, []string{"exactlyNExist", "atLeastNAreAvailable", "exactlyNAreAvailable"}, assessSteps(deployment))
+
+	secret := asserts[1]
+	require.Equal(t, "Secret", assertion.AsFeature(secret).Name())
+	require.Equal(t, map[string]string{"app": "test"}, secret.GetLabels())
+	require.Equal(t, []string{"exactlyNHaveContent", "whereCEL", "fieldMatches"}, assessSteps(secret))
+
+	require.Equal(t, "Namespace", assertion.AsFeature(asserts[2]).Name())
+
+	service := asserts[3]
+	require.Equal(t, "Service", assertion.AsFeature(service).Name())
+	require.Equal(t, []string{"exactlyNRespondHTTP"}, assessSteps(service))
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		Name string
+		Spec string
+		Err  error
+	}{
+		{
+			Name: "MissingAPIVersion",
+			Spec: "kind: AssertionSuite\nassertions: []",
+			Err:  spec.ErrUnsupportedVersion,
+		},
+		{
+			Name: "UnsupportedAPIVersion",
+			Spec: "apiVersion: kubeassert/v2\nkind: AssertionSuite\nassertions: []",
+			Err:  spec.ErrUnsupportedVersion,
+		},
+		{
+			Name: "UnknownKind",
+			Spec: header + "assertions: [{kind: Widget, checks: [exists]}]",
+			Err:  spec.ErrUnknownKind,
+		},
+		{
+			Name: "UnknownCheck",
+			Spec: header + "assertions: [{kind: Deployment, checks: [isPurple]}]",
+			Err:  spec.ErrUnknownCheck,
+		},
+		{
+			Name: "NotAnAssertionMethod",
+			Spec: header + "assertions: [{kind: Deployment, checks: [getTimeout]}]",
+			Err:  spec.ErrUnknownCheck,
+		},
+		{
+			Name: "MissingArgument",
+			Spec: header + "assertions: [{kind: Deployment, checks: [atLeastNAreAvailable]}]",
+			Err:  spec.ErrInvalidCheck,
+		},
+		{
+			Name: "InvalidArgument",
+			Spec: header + "assertions: [{kind: Deployment, checks: [{atLeastNAreAvailable: two}]}]",
+			Err:  spec.ErrInvalidCheck,
+		},
+		{
+			Name: "PredicateArgument",
+			Spec: header + "assertions: [{kind: Deployment, checks: [{exactlyNMatch: [step, 1, x]}]}]",
+			Err:  spec.ErrInvalidCheck,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := spec.Load(strings.NewReader(test.Spec))
+			require.ErrorIs(t, err, test.Err)
+		})
+	}
+}
+
+func TestLoad_UnknownField(t *testing.T) {
+	_, err := spec.Load(strings.NewReader(header + "assertions: [{kind: Deployment, nmespace: default, checks: [exists]}]"))
+	require.Error(t, err)
+}
+
+func TestJSONSchema_UpToDate(t *testing.T) {
+	schema, err := spec.JSONSchema()
+	require.NoError(t, err)
+
+	committed, err := os.ReadFile(schemaPath)
+	require.NoError(t, err)
+
+	require.JSONEq(
+		t,
+		string(committed),
+		string(schema),
+		"%s is out of date, regenerate it with: go run ./cmd/kubeassert -print-schema",
+		schemaPath,
+	)
+}
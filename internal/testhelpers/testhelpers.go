@@ -4,6 +4,7 @@ package testhelpers
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -20,13 +21,16 @@ type (
 	// This enables testing assertions for expected failures.
 	MockT struct {
 		Failed bool
+		Errors []string
 	}
 )
 
 const randomNamespaceNameLength = 20
 
-// Errorf is a no-op function that satisfies the require.TestingT interface.
-func (*MockT) Errorf(_ string, _ ...interface{}) {}
+// Errorf records the error message so that tests can inspect why an assertion failed.
+func (t *MockT) Errorf(format string, args ...interface{}) {
+	t.Errors = append(t.Errors, fmt.Sprintf(format, args...))
+}
 
 // FailNow sets the Failed field to true, indicating that a failing assertion was detected.
 func (t *MockT) FailNow() {
@@ -5,49 +5,151 @@
 package kubeassert
 
 import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/DWSR/kubeassert-go/internal/assertion"
 	"github.com/DWSR/kubeassert-go/internal/assertionhelpers"
+	"github.com/DWSR/kubeassert-go/internal/clients"
 	"github.com/DWSR/kubeassert-go/internal/crds"
 	"github.com/DWSR/kubeassert-go/internal/deployments"
+	"github.com/DWSR/kubeassert-go/internal/events"
+	"github.com/DWSR/kubeassert-go/internal/fakecluster"
+	"github.com/DWSR/kubeassert-go/internal/manifests"
+	"github.com/DWSR/kubeassert-go/internal/metrics"
 	"github.com/DWSR/kubeassert-go/internal/namespaces"
 	"github.com/DWSR/kubeassert-go/internal/pdbs"
 	"github.com/DWSR/kubeassert-go/internal/pods"
+	"github.com/DWSR/kubeassert-go/internal/report"
+	"github.com/DWSR/kubeassert-go/internal/resources"
 	"github.com/DWSR/kubeassert-go/internal/secrets"
+	"github.com/DWSR/kubeassert-go/internal/services"
+	"github.com/DWSR/kubeassert-go/internal/spec"
 )
 
 type (
-	Assertion           = assertion.Assertion
-	DeploymentAssertion = deployments.DeploymentAssertion
-	NamespaceAssertion  = namespaces.NamespaceAssertion
-	CRDAssertion        = crds.CRDAssertion
-	PDBAssertion        = pdbs.PDBAssertion
-	PodAssertion        = pods.PodAssertion
-	SecretAssertion     = secrets.SecretAssertion
+	Assertion               = assertion.Assertion
+	DeploymentAssertion     = deployments.DeploymentAssertion
+	EventAssertion          = events.EventAssertion
+	NamespaceAssertion      = namespaces.NamespaceAssertion
+	CRDAssertion            = crds.CRDAssertion
+	PDBAssertion            = pdbs.PDBAssertion
+	PodAssertion            = pods.PodAssertion
+	ResourceAssertion       = resources.ResourceAssertion
+	SecretAssertion         = secrets.SecretAssertion
+	ServiceAssertion        = services.ServiceAssertion
+	Predicate[T any]        = assertion.Predicate[T]
+	FieldMatcher            = assertion.FieldMatcher
+	Monitor                 = assertion.Monitor
+	Evaluator               = assertion.Evaluator
+	ObjectEvaluator         = assertion.ObjectEvaluator
+	ObjectLister            = events.ObjectLister
+	Filter                  = assertion.Filter
+	ClusterPredicate[T any] = assertion.ClusterPredicate[T]
+	ContextPredicate[T any] = assertion.ContextPredicate[T]
+	LogOption               = pods.LogOption
+	Result                  = assertion.Result
+	CheckResult             = assertion.CheckResult
+	Reporter                = report.Reporter
+	Record                  = report.Record
+	Violation               = assertion.Violation
+	FakeCluster             = fakecluster.Cluster
+	FakeClusterStep         = fakecluster.Step
 )
 
 var (
-	WithLabels           = assertion.WithResourceLabels
-	WithFields           = assertion.WithResourceFields
-	WithInterval         = assertion.WithInterval
-	WithTimeout          = assertion.WithTimeout
-	WithBuilder          = assertion.WithBuilder
-	WithRequireT         = assertion.WithRequireT
-	WithNamespace        = assertion.WithResourceNamespace
-	WithNamespaceFromEnv = assertion.WithResourceNamespaceFromTestEnv
-	WithResourceName     = assertion.WithResourceName
-	WithSetup            = assertion.WithSetup
-	WithTeardown         = assertion.WithTeardown
+	WithLabels            = assertion.WithResourceLabels
+	WithLabelSelector     = assertion.WithLabelSelector
+	WithLabelRequirements = assertion.WithLabelRequirements
+	WithFields            = assertion.WithResourceFields
+	WithInterval          = assertion.WithInterval
+	WithTimeout           = assertion.WithTimeout
+	WithWatch             = assertion.WithWatch
+	WithReporter          = assertion.WithReporter
+	WithTracerProvider    = assertion.WithTracerProvider
+	WithBuilder           = assertion.WithBuilder
+	WithRequireT          = assertion.WithRequireT
+	WithNamespace         = assertion.WithResourceNamespace
+	WithNamespaceFromEnv  = assertion.WithResourceNamespaceFromTestEnv
+	WithResourceName      = assertion.WithResourceName
+	WithSetup             = assertion.WithSetup
+	WithTeardown          = assertion.WithTeardown
+	WithEventType         = events.WithType
+	WithEventReason       = events.WithReason
+	WithRegarding         = events.WithRegarding
+	InContainer           = pods.InContainer
+	LogsSince             = pods.SinceTime
+	PreviousLogs          = pods.Previous
+	LogsTailLines         = pods.TailLines
+	AllLogLines           = pods.AllLines
 
 	NewDeploymentAssertion = deployments.NewDeploymentAssertion
+	NewEventAssertion      = events.NewEventAssertion
 	NewNamespaceAssertion  = namespaces.NewNamespaceAssertion
 	NewCRDAssertion        = crds.NewCRDAssertion
 	NewPDBAssertion        = pdbs.NewPDBAssertion
 	NewPodAssertion        = pods.NewPodAssertion
 	NewSecretAssertion     = secrets.NewSecretAssertion
+	NewServiceAssertion    = services.NewServiceAssertion
+	NewReporter            = report.NewReporter
+	RegisterMetrics        = metrics.Register
+	ReleaseRESTConfig      = clients.Release
 
 	ApplyKustomization     = assertionhelpers.ApplyKustomization
 	CreateResourceFromPath = assertionhelpers.CreateResourceFromPath
 	DeleteResourceFromPath = assertionhelpers.DeleteResourceFromPath
 	Sleep                  = assertionhelpers.Sleep
 	TestAssertions         = assertionhelpers.TestAssertions
+
+	LoadAssertions         = spec.Load
+	LoadAssertionsFromFile = spec.LoadFile
+	AssertionsJSONSchema   = spec.JSONSchema
+
+	ReadManifests       = manifests.ReadFiles
+	RenderKustomization = manifests.RenderKustomization
+
+	NewFakeCluster          = fakecluster.New
+	NewFakeClusterFromFiles = fakecluster.NewFromFiles
+	ApplyAfter              = fakecluster.ApplyAfter
+	DeleteAfter             = fakecluster.DeleteAfter
+	SetStatusAfter          = fakecluster.SetStatusAfter
+
+	Equals        = assertion.Equals
+	MatchesRegexp = assertion.MatchesRegexp
+	IsPresent     = assertion.IsPresent
+	IsAbsent      = assertion.IsAbsent
+
+	ErrInvalidLabelSelector = assertion.ErrInvalidLabelSelector
+	ErrInvalidCEL           = assertion.ErrInvalidCEL
+	ErrCELEvaluation        = assertion.ErrCELEvaluation
+	ErrInvalidJSONPath      = assertion.ErrInvalidJSONPath
+	ErrInvalidFieldMatcher  = assertion.ErrInvalidFieldMatcher
+	ErrClusterRequired      = assertion.ErrClusterRequired
+	ErrMonitorViolated      = assertion.ErrMonitorViolated
+	ErrMonitorNotEvaluated  = assertion.ErrMonitorNotEvaluated
+	ErrNotConsistent        = assertion.ErrNotConsistent
+	ErrInvalidPattern       = assertion.ErrInvalidPattern
+	ErrProxyNotSupported    = assertion.ErrProxyNotSupported
+	ErrLogsNotSupported     = pods.ErrLogsNotSupported
+	ErrEmptyCommand         = pods.ErrEmptyCommand
+	ErrExecNotSupported     = pods.ErrExecNotSupported
 )
+
+// Not returns a Predicate that is satisfied when the supplied predicate is not.
+func Not[T any](predicate Predicate[T]) Predicate[T] {
+	return assertion.Not(predicate)
+}
+
+// CELPredicate compiles the CEL expression into a Predicate that is evaluated against each resource, which is available
+// as self.
+func CELPredicate[T any](expression string) (Predicate[T], error) {
+	return assertion.CELPredicate[T](expression)
+}
+
+// NewResourceAssertion creates a new ResourceAssertion for the resources identified by the GroupVersionResource or
+// GroupVersionKind (e.g. custom resources) with the supplied options.
+func NewResourceAssertion[R schema.GroupVersionResource | schema.GroupVersionKind](
+	resource R,
+	opts ...assertion.Option,
+) ResourceAssertion {
+	return resources.NewResourceAssertion(resource, opts...)
+}